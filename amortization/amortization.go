@@ -0,0 +1,226 @@
+// Package amortization generates the payment schedule of a fixed-rate loan on decimal.Decimal.
+//
+// Every line of the schedule is rounded to the scale of the currency, and the last payment is adjusted
+// so that the balance ends at exactly 0, which means the payments sum exactly to the principal plus the interest.
+//
+// Example:
+//
+//	s, err := amortization.New(amortization.Loan{
+//		Principal:  decimal.Require("200000"),
+//		AnnualRate: decimal.Require("0.06"),
+//		Term:       360,
+//		Payment:    amortization.Monthly,
+//		Scale:      2,
+//	})
+//	s.Lines[0] // {Period: 1, Payment: "1199.1", Interest: "1000", Principal: "199.1", Balance: "199800.9"}
+//	s.WriteCSV(os.Stdout)
+package amortization
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/yanun0323/decimal"
+	"github.com/yanun0323/decimal/financial"
+)
+
+// ratePrecision is the count of the decimal places of the periodic rate which isn't exact, e.g. 0.05 / 12.
+const ratePrecision = 30
+
+// ErrInvalidLoan is returned when the terms of the loan can't be amortized.
+var ErrInvalidLoan = errors.New("invalid loan")
+
+// Frequency is the count of the periods per year.
+type Frequency int
+
+// The common frequencies.
+const (
+	Annually     Frequency = 1
+	SemiAnnually Frequency = 2
+	Quarterly    Frequency = 4
+	Monthly      Frequency = 12
+	SemiMonthly  Frequency = 24
+	BiWeekly     Frequency = 26
+	Weekly       Frequency = 52
+)
+
+// Loan is the terms of a fixed-rate loan.
+type Loan struct {
+	// Principal is the amount borrowed, which must be positive and have at most Scale decimal places.
+	Principal decimal.Decimal
+	// AnnualRate is the nominal annual interest rate, e.g. 0.06 for 6%.
+	AnnualRate decimal.Decimal
+	// Term is the count of the payments, e.g. 360 for 30 years of the monthly payments.
+	Term int
+	// Payment is the frequency of the payments.
+	Payment Frequency
+	// Compounding is the frequency of the compounding, the same as Payment when it's 0.
+	// e.g. the Canadian mortgages compound semi-annually and pay monthly.
+	Compounding Frequency
+	// Scale is the count of the decimal places of the currency, e.g. 2 for USD.
+	Scale int
+	// Rounding is the rounding mode of the payment and the interest of each line, RoundingHalfUp by default.
+	Rounding decimal.RoundingMode
+}
+
+// Line is one period of the schedule.
+type Line struct {
+	// Period is the 1-based index of the period.
+	Period int
+	// Payment is the amount paid in the period, the sum of Interest and Principal.
+	Payment decimal.Decimal
+	// Interest is the interest part of the payment.
+	Interest decimal.Decimal
+	// Principal is the principal part of the payment.
+	Principal decimal.Decimal
+	// Balance is the principal remaining after the payment.
+	Balance decimal.Decimal
+}
+
+// Schedule is the amortization schedule of a loan.
+type Schedule struct {
+	Loan Loan
+	// PeriodicRate is the interest rate per payment period.
+	PeriodicRate decimal.Decimal
+	// Lines has a line for each payment, and the balance of the last line is 0.
+	Lines []Line
+}
+
+// New generates the amortization schedule of the loan.
+//
+// The constant payment is rounded to the scale, and the interest of each line is the balance times the periodic rate
+// rounded to the scale, so the last payment absorbs the rounding differences.
+func New(loan Loan) (Schedule, error) {
+	if err := loan.validate(); err != nil {
+		return Schedule{}, err
+	}
+
+	// the principal is negated, so the payment is positive and it's rounded in the direction of the rounding mode
+	rate := loan.periodicRate()
+	payment, err := financial.PMT(rate, loan.Term, loan.Principal.Neg(), decimal.Zero, financial.End,
+		financial.Options{Precision: loan.Scale, Rounding: loan.Rounding})
+	if err != nil {
+		return Schedule{}, fmt.Errorf("%w: %w", ErrInvalidLoan, err)
+	}
+
+	balance := loan.Principal
+	lines := make([]Line, loan.Term)
+	for i := range lines {
+		interest := balance.Mul(rate).RoundWithMode(loan.Scale, loan.Rounding)
+		principal := payment.Sub(interest)
+		if i == len(lines)-1 || principal.GreaterThan(balance) {
+			// pay off the balance
+			principal = balance
+		}
+
+		balance = balance.Sub(principal)
+		lines[i] = Line{
+			Period:    i + 1,
+			Payment:   interest.Add(principal),
+			Interest:  interest,
+			Principal: principal,
+			Balance:   balance,
+		}
+
+		if balance.IsZero() {
+			lines = lines[:i+1]
+			break
+		}
+	}
+
+	return Schedule{Loan: loan, PeriodicRate: rate, Lines: lines}, nil
+}
+
+// TotalPayment returns the sum of the payments.
+func (s Schedule) TotalPayment() decimal.Decimal {
+	return s.sum(func(l Line) decimal.Decimal { return l.Payment })
+}
+
+// TotalInterest returns the sum of the interest.
+func (s Schedule) TotalInterest() decimal.Decimal {
+	return s.sum(func(l Line) decimal.Decimal { return l.Interest })
+}
+
+// WriteCSV writes the schedule as CSV with a header row, the amounts have the digits of the scale of the loan.
+//
+//	period,payment,interest,principal,balance
+//	1,1199.10,1000.00,199.10,199800.90
+func (s Schedule) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"period", "payment", "interest", "principal", "balance"}); err != nil {
+		return err
+	}
+
+	scale := s.Loan.Scale
+	for _, l := range s.Lines {
+		record := []string{
+			strconv.Itoa(l.Period),
+			l.Payment.StringFixed(scale),
+			l.Interest.StringFixed(scale),
+			l.Principal.StringFixed(scale),
+			l.Balance.StringFixed(scale),
+		}
+
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func (s Schedule) sum(field func(Line) decimal.Decimal) decimal.Decimal {
+	var acc decimal.Accumulator
+	for _, l := range s.Lines {
+		acc.AddInPlace(field(l))
+	}
+
+	return acc.Decimal()
+}
+
+func (loan Loan) validate() error {
+	switch {
+	case loan.Principal.Sign() <= 0:
+		return fmt.Errorf("%w: principal %s", ErrInvalidLoan, loan.Principal)
+	case loan.AnnualRate.Sign() < 0:
+		return fmt.Errorf("%w: annual rate %s", ErrInvalidLoan, loan.AnnualRate)
+	case loan.Term <= 0:
+		return fmt.Errorf("%w: term %d", ErrInvalidLoan, loan.Term)
+	case loan.Payment <= 0:
+		return fmt.Errorf("%w: payment frequency %d", ErrInvalidLoan, loan.Payment)
+	case loan.Compounding < 0:
+		return fmt.Errorf("%w: compounding frequency %d", ErrInvalidLoan, loan.Compounding)
+	case loan.Scale < 0:
+		return fmt.Errorf("%w: scale %d", ErrInvalidLoan, loan.Scale)
+	case !loan.Principal.Equal(loan.Principal.Round(loan.Scale)):
+		return fmt.Errorf("%w: principal %s has more decimal places than scale %d", ErrInvalidLoan, loan.Principal, loan.Scale)
+	}
+
+	return nil
+}
+
+// periodicRate returns the interest rate per payment period which is equivalent to the nominal annual rate
+// compounded at the compounding frequency.
+//
+//	(1 + rate/compounding)^(compounding/payment) - 1
+func (loan Loan) periodicRate() decimal.Decimal {
+	payment, compounding := int64(loan.Payment), int64(loan.Compounding)
+	if compounding == 0 || compounding == payment {
+		return loan.AnnualRate.DivRound(decimal.NewFromInt(payment), ratePrecision)
+	}
+
+	base := decimal.Require("1").Add(loan.AnnualRate.DivRound(decimal.NewFromInt(compounding), ratePrecision+10))
+	if compounding%payment == 0 {
+		g := base.Pow(decimal.NewFromInt(compounding / payment))
+		return g.Sub(decimal.Require("1")).Round(ratePrecision)
+	}
+
+	// the fractional power, e^(ln(base) * compounding / payment)
+	ln, _ := base.Ln(ratePrecision + 10)
+	g := ln.Mul(decimal.NewFromInt(compounding)).DivRound(decimal.NewFromInt(payment), ratePrecision+10).Exp(ratePrecision + 10)
+	return g.Sub(decimal.Require("1")).Round(ratePrecision)
+}
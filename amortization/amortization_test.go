@@ -0,0 +1,156 @@
+package amortization
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/yanun0323/decimal"
+)
+
+func TestAmortization(t *testing.T) {
+	suite.Run(t, new(AmortizationSuite))
+}
+
+type AmortizationSuite struct {
+	suite.Suite
+}
+
+func (su *AmortizationSuite) TestNew() {
+	testCases := []struct {
+		desc         string
+		loan         Loan
+		periodicRate string
+		first, last  Line
+		lines        int
+	}{
+		{
+			desc:         "Mortgage",
+			loan:         Loan{Principal: "200000", AnnualRate: "0.06", Term: 360, Payment: Monthly, Scale: 2},
+			periodicRate: "0.005",
+			first:        Line{Period: 1, Payment: "1199.1", Interest: "1000", Principal: "199.1", Balance: "199800.9"},
+			last:         Line{Period: 360, Payment: "1200.14", Interest: "5.97", Principal: "1194.17", Balance: "0"},
+			lines:        360,
+		},
+		{
+			desc:         "Semi-Annual Compounding",
+			loan:         Loan{Principal: "100000", AnnualRate: "0.05", Term: 300, Payment: Monthly, Compounding: SemiAnnually, Scale: 2},
+			periodicRate: "0.004123915465144271401093578689",
+			first:        Line{Period: 1, Payment: "581.6", Interest: "412.39", Principal: "169.21", Balance: "99830.79"},
+			lines:        300,
+		},
+		{
+			desc:         "Monthly Compounding Annual Payment",
+			loan:         Loan{Principal: "100000", AnnualRate: "0.06", Term: 4, Payment: Annually, Compounding: Monthly, Scale: 0},
+			periodicRate: "0.061677811864499568789707617432",
+			first:        Line{Period: 1, Payment: "28970", Interest: "6168", Principal: "22802", Balance: "77198"},
+			last:         Line{Period: 4, Payment: "28970", Interest: "1683", Principal: "27287", Balance: "0"},
+			lines:        4,
+		},
+		{
+			desc:         "Zero Rate",
+			loan:         Loan{Principal: "1000", AnnualRate: "0", Term: 3, Payment: Monthly, Scale: 2},
+			periodicRate: "0",
+			first:        Line{Period: 1, Payment: "333.33", Interest: "0", Principal: "333.33", Balance: "666.67"},
+			last:         Line{Period: 3, Payment: "333.34", Interest: "0", Principal: "333.34", Balance: "0"},
+			lines:        3,
+		},
+		{
+			desc:         "Payment Rounded Up",
+			loan:         Loan{Principal: "1000", AnnualRate: "0.12", Term: 3, Payment: Monthly, Scale: 0, Rounding: decimal.RoundingCeil},
+			periodicRate: "0.01",
+			first:        Line{Period: 1, Payment: "341", Interest: "10", Principal: "331", Balance: "669"},
+			last:         Line{Period: 3, Payment: "339", Interest: "4", Principal: "335", Balance: "0"},
+			lines:        3,
+		},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			s, err := New(tc.loan)
+			su.Require().NoError(err)
+			su.Equal(tc.periodicRate, s.PeriodicRate.String())
+			su.Require().Len(s.Lines, tc.lines)
+			su.assertLine(tc.first, s.Lines[0])
+			if tc.last.Period != 0 {
+				su.assertLine(tc.last, s.Lines[len(s.Lines)-1])
+			}
+
+			su.assertSchedule(s)
+		})
+	}
+}
+
+func (su *AmortizationSuite) TestTotals() {
+	s, err := New(Loan{Principal: "200000", AnnualRate: "0.06", Term: 360, Payment: Monthly, Scale: 2})
+	su.Require().NoError(err)
+	su.Equal("431677.04", s.TotalPayment().String())
+	su.Equal("231677.04", s.TotalInterest().String())
+}
+
+func (su *AmortizationSuite) TestWriteCSV() {
+	s, err := New(Loan{Principal: "1000", AnnualRate: "0.05", Term: 12, Payment: Monthly, Scale: 2})
+	su.Require().NoError(err)
+
+	buf := &bytes.Buffer{}
+	su.Require().NoError(s.WriteCSV(buf))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	su.Require().Len(lines, 13)
+	su.Equal("period,payment,interest,principal,balance", lines[0])
+	su.Equal("1,85.61,4.17,81.44,918.56", lines[1])
+	su.Equal("5,85.61,2.80,82.81,589.38", lines[5])
+	su.Equal("12,85.59,0.36,85.23,0.00", lines[12])
+}
+
+func (su *AmortizationSuite) TestInvalidLoan() {
+	valid := Loan{Principal: "1000", AnnualRate: "0.05", Term: 12, Payment: Monthly, Scale: 2}
+	testCases := []struct {
+		desc   string
+		modify func(l *Loan)
+	}{
+		{"Zero Principal", func(l *Loan) { l.Principal = decimal.Zero }},
+		{"Negative Rate", func(l *Loan) { l.AnnualRate = "-0.01" }},
+		{"Zero Term", func(l *Loan) { l.Term = 0 }},
+		{"No Payment Frequency", func(l *Loan) { l.Payment = 0 }},
+		{"Negative Compounding", func(l *Loan) { l.Compounding = -1 }},
+		{"Negative Scale", func(l *Loan) { l.Scale = -1 }},
+		{"Principal Finer Than Scale", func(l *Loan) { l.Principal = "1000.005" }},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			loan := valid
+			tc.modify(&loan)
+			_, err := New(loan)
+			su.ErrorIs(err, ErrInvalidLoan)
+		})
+	}
+}
+
+func (su *AmortizationSuite) assertLine(expected, actual Line) {
+	su.Equal(expected.Period, actual.Period)
+	su.Equal(expected.Payment.String(), actual.Payment.String(), "payment of period %d", expected.Period)
+	su.Equal(expected.Interest.String(), actual.Interest.String(), "interest of period %d", expected.Period)
+	su.Equal(expected.Principal.String(), actual.Principal.String(), "principal of period %d", expected.Period)
+	su.Equal(expected.Balance.String(), actual.Balance.String(), "balance of period %d", expected.Period)
+}
+
+// assertSchedule checks the lines are rounded to the scale, the parts sum to the payments,
+// and the principal parts sum exactly to the principal.
+func (su *AmortizationSuite) assertSchedule(s Schedule) {
+	balance := s.Loan.Principal
+	for _, l := range s.Lines {
+		for _, d := range []decimal.Decimal{l.Payment, l.Interest, l.Principal, l.Balance} {
+			su.True(d.Equal(d.Round(s.Loan.Scale)), "period %d: %s is not rounded", l.Period, d)
+		}
+
+		su.True(l.Payment.Equal(l.Interest.Add(l.Principal)), "period %d", l.Period)
+		balance = balance.Sub(l.Principal)
+		su.True(balance.Equal(l.Balance), "period %d", l.Period)
+	}
+
+	su.True(balance.IsZero())
+	su.True(s.TotalPayment().Equal(s.Loan.Principal.Add(s.TotalInterest())))
+}
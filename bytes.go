@@ -67,6 +67,11 @@ func newDecimal(buf []byte) ([]byte, error) {
 		return zeroBytes, nil
 	}
 
+	// the decimal produced by this package skips the parsing
+	if isTidy(buf, false) {
+		return buf, nil
+	}
+
 	if quickCheckZero(buf) {
 		return zeroBytes, nil
 	}
@@ -526,8 +531,12 @@ func unsignedSub(a, b []byte) []byte {
 	return tidyBytes(trimFront(result, resultIdx+1))
 }
 
-func isZero(buf []byte) bool {
-	for _, c := range buf {
+// isZero returns true if buf represents zero.
+//
+// NOTE: NO COPY
+func isZero[T ~string | ~[]byte](buf T) bool {
+	for i := 0; i < len(buf); i++ {
+		c := buf[i]
 		switch c {
 		case '-':
 			continue
@@ -540,157 +549,6 @@ func isZero(buf []byte) bool {
 	return true
 }
 
-func isNegative(buf []byte) bool {
-	return buf[0] == '-'
-}
-
-// great return true if the d1 > d2
-//
-//	example: 1234.001 vs 12.00001
-//	1234.001**
-//	**12.00001
-//	^ // <- pointer go backward
-//	example: 1234.00001 vs 12.1
-//	1234.00001
-//	**12.1****
-//	^ // <- pointer go backward
-func great(a, b []byte) bool {
-	sA, sB := sign(a), sign(b)
-	if sA != sB {
-		return sA > sB
-	}
-
-	if sA == 0 { // both zero
-		return false
-	}
-
-	// strip leading '-'
-	if sA == -1 {
-		a = trimFront(a, 1)
-		b = trimFront(b, 1)
-	}
-
-	aDotIdx := findDotIndex(a)
-	if aDotIdx == -1 {
-		a = pushBack(a, '.')
-		aDotIdx = len(a) - 1
-	}
-
-	bDotIdx := findDotIndex(b)
-	if bDotIdx == -1 {
-		b = pushBack(b, '.')
-		bDotIdx = len(b) - 1
-	}
-
-	maxLenAfterDotIdx := max(len(a)-aDotIdx-1, len(b)-bDotIdx-1)
-
-	if aDotIdx != bDotIdx {
-		cmp := aDotIdx > bDotIdx
-		if sA == -1 { // for negative numbers invert result
-			return !cmp
-		}
-		return cmp
-	}
-
-	count := aDotIdx + maxLenAfterDotIdx + 1
-	for i := 0; i < count; i++ {
-		if len(a) == i {
-			return false
-		}
-
-		if len(b) == i {
-			return true
-		}
-
-		if a[i] == '.' {
-			continue
-		}
-
-		if a[i] != b[i] {
-			cmp := a[i] > b[i]
-			if sA == -1 {
-				return !cmp
-			}
-			return cmp
-		}
-	}
-
-	return false
-}
-
-// less return true if the d1 < d2
-//
-//	example: 1234.001 vs 12.00001
-//	1234.001**
-//	**12.00001
-//	^ // <- pointer go backward
-//	example: 1234.00001 vs 12.1
-//	1234.00001
-//	**12.1****
-//	^ // <- pointer go backward
-func less(a, b []byte) bool {
-	sA, sB := sign(a), sign(b)
-	if sA != sB {
-		return sA < sB
-	}
-
-	if sA == 0 {
-		return false
-	}
-
-	if sA == -1 {
-		a = trimFront(a, 1)
-		b = trimFront(b, 1)
-	}
-
-	aDotIdx := findDotIndex(a)
-	if aDotIdx == -1 {
-		a = pushBack(a, '.')
-		aDotIdx = len(a) - 1
-	}
-
-	bDotIdx := findDotIndex(b)
-	if bDotIdx == -1 {
-		b = pushBack(b, '.')
-		bDotIdx = len(b) - 1
-	}
-
-	maxLenAfterDotIdx := max(len(a)-aDotIdx-1, len(b)-bDotIdx-1)
-
-	if aDotIdx != bDotIdx {
-		cmp := aDotIdx < bDotIdx
-		if sA == -1 {
-			return !cmp
-		}
-		return cmp
-	}
-
-	count := aDotIdx + maxLenAfterDotIdx + 1
-	for i := 0; i < count; i++ {
-		if len(a) == i {
-			return true
-		}
-
-		if len(b) == i {
-			return false
-		}
-
-		if a[i] == '.' {
-			continue
-		}
-
-		if a[i] != b[i] {
-			cmp := a[i] < b[i]
-			if sA == -1 {
-				return !cmp
-			}
-			return cmp
-		}
-	}
-
-	return false
-}
-
 func intPart(buf []byte) []byte {
 	dotIdx := findDotIndex(buf)
 	if dotIdx != -1 {
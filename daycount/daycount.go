@@ -0,0 +1,202 @@
+// Package daycount provides the day count conventions which turn the period between two dates into a year fraction
+// for the accrued interest of bonds and deposits.
+//
+// The year fractions are exact ratios, e.g. 181/360, and the time of day of the dates is ignored.
+//
+// Example:
+//
+//	start := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+//	end := time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)
+//	daycount.Actual360.YearFraction(start, end).String()   // "0.5055555555555556"
+//	daycount.Thirty360US.YearFraction(start, end).String() // "0.5"
+//	daycount.AccruedInterest(decimal.Require("1000000"), decimal.Require("0.05"), start, end, daycount.Actual360) // "25277.7777777777777778"
+package daycount
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/yanun0323/decimal"
+)
+
+// Convention is a day count convention.
+type Convention int
+
+const (
+	// Actual360 is the actual days over 360 days a year, ACT/360.
+	Actual360 Convention = iota
+	// Actual365Fixed is the actual days over 365 days a year regardless of the leap years, ACT/365F.
+	Actual365Fixed
+	// ActualActualISDA is the actual days in each year over the days of that year, 365 or 366, ACT/ACT ISDA.
+	ActualActualISDA
+	// Thirty360US is the 30-day months over 360 days a year with the end-of-month rules of February, 30/360 US.
+	Thirty360US
+	// Thirty360European is the 30-day months over 360 days a year, 30E/360.
+	Thirty360European
+)
+
+// String returns the market name of the convention, e.g. "ACT/360".
+func (c Convention) String() string {
+	switch c {
+	case Actual360:
+		return "ACT/360"
+	case Actual365Fixed:
+		return "ACT/365F"
+	case ActualActualISDA:
+		return "ACT/ACT ISDA"
+	case Thirty360US:
+		return "30/360 US"
+	case Thirty360European:
+		return "30E/360"
+	default:
+		return fmt.Sprintf("Convention(%d)", int(c))
+	}
+}
+
+// DayCount returns the count of the days from start to end under the convention,
+// which is negative when end is before start.
+//
+// Example:
+//
+//	start := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+//	end := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+//	daycount.Actual360.DayCount(start, end)         // 60
+//	daycount.Thirty360European.DayCount(start, end) // 60
+func (c Convention) DayCount(start, end time.Time) int64 {
+	if civil(end).Before(civil(start)) {
+		return -c.DayCount(end, start)
+	}
+
+	switch c {
+	case Actual360, Actual365Fixed, ActualActualISDA:
+		return actualDays(start, end)
+	case Thirty360US:
+		return thirty360(start, end, true)
+	case Thirty360European:
+		return thirty360(start, end, false)
+	default:
+		panic(fmt.Sprintf("daycount: unknown convention %d", int(c)))
+	}
+}
+
+// YearFractionRat returns the exact year fraction from start to end under the convention,
+// which is negative when end is before start.
+//
+// Example:
+//
+//	start := time.Date(2003, 11, 1, 0, 0, 0, 0, time.UTC)
+//	end := time.Date(2004, 5, 1, 0, 0, 0, 0, time.UTC)
+//	daycount.ActualActualISDA.YearFractionRat(start, end) // 61/365 + 121/366
+func (c Convention) YearFractionRat(start, end time.Time) *big.Rat {
+	if civil(end).Before(civil(start)) {
+		r := c.YearFractionRat(end, start)
+		return r.Neg(r)
+	}
+
+	switch c {
+	case Actual360, Thirty360US, Thirty360European:
+		return big.NewRat(c.DayCount(start, end), 360)
+	case Actual365Fixed:
+		return big.NewRat(c.DayCount(start, end), 365)
+	case ActualActualISDA:
+		return actualActualISDA(start, end)
+	default:
+		panic(fmt.Sprintf("daycount: unknown convention %d", int(c)))
+	}
+}
+
+// YearFraction returns the year fraction from start to end under the convention,
+// rounded half away from zero to DivisionPrecision decimal places, use YearFractionRat for the exact value.
+func (c Convention) YearFraction(start, end time.Time) decimal.Decimal {
+	return decimal.NewFromRat(c.YearFractionRat(start, end), decimal.DivisionPrecision, decimal.RoundingHalfUp)
+}
+
+// AccruedInterest returns principal * rate * the year fraction from start to end under the convention.
+// The product is exact before it's rounded half away from zero to DivisionPrecision decimal places,
+// round it to the scale of the currency for the payment.
+//
+// Example:
+//
+//	start := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+//	end := time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC)
+//	daycount.AccruedInterest(decimal.Require("1000000"), decimal.Require("0.05"), start, end, daycount.Thirty360US) // "25000"
+func AccruedInterest(principal, rate decimal.Decimal, start, end time.Time, c Convention) decimal.Decimal {
+	r := c.YearFractionRat(start, end)
+	r.Mul(r, principal.Rat())
+	r.Mul(r, rate.Rat())
+	return decimal.NewFromRat(r, decimal.DivisionPrecision, decimal.RoundingHalfUp)
+}
+
+// actualActualISDA returns the days in the leap years over 366 plus the days in the other years over 365.
+func actualActualISDA(start, end time.Time) *big.Rat {
+	y1, y2 := start.Year(), end.Year()
+	if y1 == y2 {
+		return big.NewRat(actualDays(start, end), daysInYear(y1))
+	}
+
+	// the first partial year, the whole years between, and the last partial year
+	first := big.NewRat(actualDays(start, newYear(y1+1)), daysInYear(y1))
+	last := big.NewRat(actualDays(newYear(y2), end), daysInYear(y2))
+
+	result := new(big.Rat).SetInt64(int64(y2 - y1 - 1))
+	result.Add(result, first)
+	return result.Add(result, last)
+}
+
+// thirty360 returns the days of the 30/360 conventions, the day of the month is adjusted to at most 30.
+// The 30/360 US rules also treat the last day of February as the 30th, the same as the spreadsheet YEARFRAC basis 0.
+func thirty360(start, end time.Time, us bool) int64 {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+
+	if us {
+		if isLastDayOfFebruary(start) {
+			if isLastDayOfFebruary(end) {
+				d2 = 30
+			}
+
+			d1 = 30
+		}
+
+		if d2 == 31 && d1 >= 30 {
+			d2 = 30
+		}
+
+		if d1 == 31 {
+			d1 = 30
+		}
+	} else {
+		d1, d2 = min(d1, 30), min(d2, 30)
+	}
+
+	return 360*int64(y2-y1) + 30*int64(m2-m1) + int64(d2-d1)
+}
+
+// actualDays returns the count of the calendar days from the date of start to the date of end.
+func actualDays(start, end time.Time) int64 {
+	return int64(civil(end).Sub(civil(start)) / (24 * time.Hour))
+}
+
+// civil returns the midnight UTC of the date of t, so the time of day and the daylight saving time are ignored.
+func civil(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newYear(year int) time.Time {
+	return time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func daysInYear(year int) int64 {
+	if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+		return 366
+	}
+
+	return 365
+}
+
+func isLastDayOfFebruary(t time.Time) bool {
+	next := civil(t).AddDate(0, 0, 1)
+	return next.Month() == time.March && next.Day() == 1
+}
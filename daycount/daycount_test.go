@@ -0,0 +1,130 @@
+package daycount
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/yanun0323/decimal"
+)
+
+func TestDayCount(t *testing.T) {
+	suite.Run(t, new(DayCountSuite))
+}
+
+type DayCountSuite struct {
+	suite.Suite
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func (su *DayCountSuite) TestDayCount() {
+	testCases := []struct {
+		desc       string
+		start, end time.Time
+		expected   map[Convention]int64
+	}{
+		{"Same Month", date(2024, 1, 15), date(2024, 1, 25), map[Convention]int64{
+			Actual360: 10, Actual365Fixed: 10, ActualActualISDA: 10, Thirty360US: 10, Thirty360European: 10,
+		}},
+		{"Leap February", date(2024, 1, 31), date(2024, 3, 31), map[Convention]int64{
+			Actual360: 60, Thirty360US: 60, Thirty360European: 60,
+		}},
+		{"End Of January To End Of February", date(2007, 1, 31), date(2007, 2, 28), map[Convention]int64{
+			Actual360: 28, Thirty360US: 28, Thirty360European: 28,
+		}},
+		{"End Of February To End Of March", date(2007, 2, 28), date(2007, 3, 31), map[Convention]int64{
+			Actual360: 31, Thirty360US: 30, Thirty360European: 32,
+		}},
+		{"End Of February To End Of February", date(2007, 2, 28), date(2008, 2, 29), map[Convention]int64{
+			Actual360: 366, Thirty360US: 360, Thirty360European: 361,
+		}},
+		{"Not End Of February", date(2008, 2, 28), date(2008, 3, 31), map[Convention]int64{
+			Actual360: 32, Thirty360US: 33, Thirty360European: 32,
+		}},
+		{"Start On 30th End On 31st", date(2007, 8, 30), date(2007, 10, 31), map[Convention]int64{
+			Actual360: 62, Thirty360US: 60, Thirty360European: 60,
+		}},
+		{"Start Before 30th End On 31st", date(2007, 8, 29), date(2007, 10, 31), map[Convention]int64{
+			Actual360: 63, Thirty360US: 62, Thirty360European: 61,
+		}},
+		{"Reversed", date(2024, 3, 31), date(2024, 1, 31), map[Convention]int64{
+			Actual360: -60, Thirty360US: -60, Thirty360European: -60,
+		}},
+		{"Time Of Day Ignored", time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC), time.Date(2024, 1, 2, 1, 0, 0, 0, time.UTC), map[Convention]int64{
+			Actual360: 1, Thirty360US: 1,
+		}},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			for c, expected := range tc.expected {
+				su.Equal(expected, c.DayCount(tc.start, tc.end), "%s %s", tc.desc, c)
+			}
+		})
+	}
+}
+
+func (su *DayCountSuite) TestYearFraction() {
+	testCases := []struct {
+		desc       string
+		c          Convention
+		start, end time.Time
+		exact      *big.Rat
+		expected   string
+	}{
+		{"ACT/360", Actual360, date(2024, 1, 15), date(2024, 7, 15), big.NewRat(182, 360), "0.5055555555555556"},
+		{"ACT/365F", Actual365Fixed, date(2024, 1, 15), date(2024, 7, 15), big.NewRat(182, 365), "0.4986301369863014"},
+		{"ACT/365F Whole Leap Year", Actual365Fixed, date(2024, 1, 1), date(2025, 1, 1), big.NewRat(366, 365), "1.0027397260273973"},
+		{"ACT/ACT ISDA Same Year", ActualActualISDA, date(2024, 1, 15), date(2024, 7, 15), big.NewRat(182, 366), "0.4972677595628415"},
+		{"ACT/ACT ISDA Across Years", ActualActualISDA, date(2003, 11, 1), date(2004, 5, 1),
+			new(big.Rat).Add(big.NewRat(61, 365), big.NewRat(121, 366)), "0.4977243805674077"},
+		// 184/365 + 2 + 181/365
+		{"ACT/ACT ISDA Whole Years", ActualActualISDA, date(2023, 7, 1), date(2026, 7, 1), big.NewRat(3, 1), "3"},
+		{"ACT/ACT ISDA Leap Year", ActualActualISDA, date(2024, 1, 1), date(2025, 1, 1), big.NewRat(1, 1), "1"},
+		{"30/360 US", Thirty360US, date(2024, 1, 15), date(2024, 7, 15), big.NewRat(1, 2), "0.5"},
+		{"30E/360", Thirty360European, date(2007, 2, 28), date(2007, 3, 31), big.NewRat(32, 360), "0.0888888888888889"},
+		{"Reversed", ActualActualISDA, date(2004, 5, 1), date(2003, 11, 1),
+			new(big.Rat).Neg(new(big.Rat).Add(big.NewRat(61, 365), big.NewRat(121, 366))), "-0.4977243805674077"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			su.Equal(tc.exact.String(), tc.c.YearFractionRat(tc.start, tc.end).String(), tc.desc)
+			su.Equal(tc.expected, tc.c.YearFraction(tc.start, tc.end).String(), tc.desc)
+		})
+	}
+}
+
+func (su *DayCountSuite) TestAccruedInterest() {
+	principal, rate := decimal.Require("1000000"), decimal.Require("0.05")
+	start, end := date(2024, 1, 15), date(2024, 7, 15)
+
+	testCases := []struct {
+		c        Convention
+		expected string
+	}{
+		{Actual360, "25277.7777777777777778"},
+		{Actual365Fixed, "24931.5068493150684932"},
+		{ActualActualISDA, "24863.3879781420765027"},
+		{Thirty360US, "25000"},
+		{Thirty360European, "25000"},
+	}
+
+	for _, tc := range testCases {
+		su.Equal(tc.expected, AccruedInterest(principal, rate, start, end, tc.c).String(), tc.c.String())
+	}
+
+	su.Equal("-25000", AccruedInterest(principal, rate, end, start, Thirty360US).String())
+}
+
+func (su *DayCountSuite) TestString() {
+	su.Equal("ACT/360", Actual360.String())
+	su.Equal("ACT/ACT ISDA", ActualActualISDA.String())
+	su.Equal("30E/360", Thirty360European.String())
+	su.Equal("Convention(9)", Convention(9).String())
+	su.Panics(func() { Convention(9).DayCount(date(2024, 1, 1), date(2024, 2, 1)) })
+}
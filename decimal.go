@@ -1,6 +1,7 @@
 package decimal
 
 import (
+	"bytes"
 	"math"
 	"math/big"
 	"regexp"
@@ -13,7 +14,8 @@ const (
 	DivisionPrecision int = 16
 
 	// Zero constant, to make computations faster.
-	// Zero should never be compared with == or != directly, please use decimal.Equal or decimal.Cmp instead.
+	// Zero should never be compared with == or != directly, please use decimal.Equal or decimal.Cmp instead,
+	// or compare the Canonical forms.
 	Zero Decimal = Decimal("0")
 
 	_zero = "0"
@@ -50,12 +52,14 @@ func Require(value string) Decimal {
 
 // NewFromInt converts a int64 to Decimal.
 func NewFromInt(value int64) Decimal {
-	return Decimal(strconv.FormatInt(value, 10))
+	// small enough to be inlined, so the string doesn't allocate when the result doesn't escape
+	var buf [20]byte
+	return Decimal(strconv.AppendInt(buf[:0], value, 10))
 }
 
 // NewFromInt32 converts a int32 to Decimal.
 func NewFromInt32(value int32) Decimal {
-	return Decimal(strconv.FormatInt(int64(value), 10))
+	return NewFromInt(int64(value))
 }
 
 // NewFromFloat create a Decimal from a float64.
@@ -78,7 +82,8 @@ func NewFromFloat32(value float32) Decimal {
 		return Zero
 	}
 
-	return Decimal(strconv.FormatFloat(vf, 'f', -1, 64))
+	// format with bitSize 32 for the shortest digits which round trip to the float32
+	return Decimal(strconv.FormatFloat(vf, 'f', -1, 32))
 }
 
 // NewFromBigInt returns a new Decimal from a big.Int, value * 10 ^ exp
@@ -87,7 +92,7 @@ func NewFromBigInt(value *big.Int, exp int) Decimal {
 }
 
 // NewFromString returns a new Decimal from a string representation.
-// Trailing zeroes are not trimmed.
+// Trailing zeroes are trimmed, use NewScaled to keep the scale.
 //
 // Acceptable symbol (+-.,_0123456789)
 //
@@ -173,11 +178,15 @@ func Max(first Decimal, rest ...Decimal) Decimal {
 
 // Sum returns the combined total of the provided first and rest Decimals
 func Sum(first Decimal, rest ...Decimal) Decimal {
-	summed := first
+	if len(rest) == 0 {
+		return first
+	}
+
+	acc := NewAccumulator(first)
 	for _, d := range rest {
-		summed = summed.Add(d)
+		acc.AddInPlace(d)
 	}
-	return summed
+	return acc.Decimal()
 }
 
 // Avg returns the average value of the provided first and rest Decimals
@@ -200,6 +209,10 @@ type Decimal string
 //
 //	-12.345
 func (d Decimal) String() string {
+	if isTidy(d, false) {
+		return string(d)
+	}
+
 	return string(normalize([]byte(d)))
 }
 
@@ -241,6 +254,14 @@ func (d Decimal) StringFixed(places int) string {
 
 // Abs returns the absolute value of the decimal.
 func (d Decimal) Abs() Decimal {
+	if isTidy(d, false) {
+		if d[0] == '-' {
+			return d[1:]
+		}
+
+		return d
+	}
+
 	buf := normalize([]byte(d))
 
 	if buf[0] == '-' {
@@ -256,6 +277,18 @@ func (d Decimal) Abs() Decimal {
 //
 //	decimal.New("123.456").Neg().String() // "-123.45"
 func (d Decimal) Neg() Decimal {
+	if isTidy(d, false) {
+		if d[0] == '-' {
+			return d[1:]
+		}
+
+		if isZero(d) {
+			return d
+		}
+
+		return "-" + d
+	}
+
 	buf := normalize([]byte(d))
 
 	if buf[0] == '-' {
@@ -321,6 +354,10 @@ func combineToDecimal(ss ...string) Decimal {
 //	d2, _ := decimal.New("90.99")
 //	d1.Add(d2).String() // "190.01"
 func (d Decimal) Add(d2 Decimal) Decimal {
+	if result, ok := addFast(d, d2, false); ok {
+		return result
+	}
+
 	b, a := normalize([]byte(d)), normalize([]byte(d2))
 	baseNegative := b[0] == '-'
 	additionNegative := a[0] == '-'
@@ -355,6 +392,10 @@ func (d Decimal) Add(d2 Decimal) Decimal {
 //	d2, _ := decimal.New("90.99")
 //	d1.Sub(d2).String() // "9.01"
 func (d Decimal) Sub(d2 Decimal) Decimal {
+	if result, ok := addFast(d, d2, true); ok {
+		return result
+	}
+
 	return Decimal(sub(normalize([]byte(d)), normalize([]byte(d2))))
 }
 
@@ -481,39 +522,54 @@ func max(a, b int) int {
 	return b
 }
 
-// Pow returns d to the power d2
+// Pow returns d to the power d2, the fraction of d2 is ignored, use PowWithPrecision for the fractional powers.
+//
+// The positive power is exact, it panics with "pow overflow" when the exact result would have more than
+// 2^18 bits, about 78,000 digits, use PowWithPrecision for the larger powers.
 func (d Decimal) Pow(d2 Decimal) Decimal {
-	cache := make([]byte, 0, len(d)*len(d2))
-	return Decimal(pow(normalize([]byte(d)), normalize([]byte(d2)), &cache))
+	return Decimal(pow(normalize([]byte(d)), normalize([]byte(d2))))
 }
 
-func pow(a, b []byte, reused ...*[]byte) []byte {
+// pow returns a^b, the operands of sqr, mul and div are copied from a
+// because they remove the decimal point in place.
+func pow(a, b []byte) []byte {
 	bIntPart := intPartInt64(b)
 	if bIntPart == 0 {
 		return oneBytes
 	}
 
 	if bIntPart == 1 {
-		return a
+		return bytes.Clone(a)
+	}
+
+	if bIntPart > 1 {
+		// the positive power is exact, big.Int saves the conversion of the digits for every squaring.
+		// the bound of the bits also keeps scale*bIntPart in an int, because 10^scale has more than scale bits.
+		c, scale := bigIntWithScale(bytes.Clone(a))
+		if !exactPowFits(c, pow10(scale), bIntPart) {
+			panic("pow overflow")
+		}
+
+		c.Exp(c, big.NewInt(bIntPart), nil)
+		return tidyBytes(shift([]byte(c.String()), -scale*int(bIntPart)))
 	}
 
-	temp := pow(a, div(b, twoBytes), reused...)
+	temp := pow(a, div(b, twoBytes))
 	if bIntPart%2 == 0 {
-		return sqr(temp, reused...)
+		return sqr(temp)
 	}
 
 	if bIntPart > 0 {
-		return mul(sqr(temp, reused...), a, reused...)
+		return mul(sqr(temp), bytes.Clone(a))
 	}
 
-	return div(sqr(temp, reused...), a)
+	return div(sqr(temp), bytes.Clone(a))
 }
 
-// Rat returns a rational number representation of the decimal.
+// Rat returns a rational number representation of the decimal, which is exact.
 func (d Decimal) Rat() *big.Rat {
-	r := new(big.Rat)
-	r.SetString(d.String())
-	return r
+	c, scale := bigIntWithScale(normalize([]byte(d)))
+	return new(big.Rat).SetFrac(c, pow10(scale))
 }
 
 // BigFloat returns decimal as BigFloat.
@@ -549,7 +605,7 @@ func (d Decimal) IsZero() bool {
 		return true
 	}
 
-	return isZero(normalize([]byte(d)))
+	return d.Canonical() == _zero
 }
 
 // IsInteger returns true when decimal can be represented as an integer value, otherwise, it returns false.
@@ -575,14 +631,12 @@ func (d Decimal) IsInteger() bool {
 
 // IsPositive return d > 0
 func (d Decimal) IsPositive() bool {
-	buf := normalize([]byte(d))
-
-	return !isZero(buf) && !isNegative(buf)
+	return d.Sign() == 1
 }
 
 // IsNegative return d < 0
 func (d Decimal) IsNegative() bool {
-	return isNegative([]byte(d))
+	return d.Sign() == -1
 }
 
 // Cmp compares the numbers represented by d and d2 and returns:
@@ -591,47 +645,44 @@ func (d Decimal) IsNegative() bool {
 //	 0 if d == d2
 //	+1 if d >  d2
 func (d Decimal) Cmp(d2 Decimal) int {
-	b := normalize([]byte(d))
-	b2 := normalize([]byte(d2))
-
-	if string(b) == string(b2) {
-		return 0
-	}
-
-	if great(b, b2) {
-		return 1
+	if result, ok := cmpFast(d, d2); ok {
+		return result
 	}
 
-	return -1
+	return compareCanonical(d.Canonical(), d2.Canonical())
 }
 
 // Equal return d == d2
 func (d Decimal) Equal(d2 Decimal) bool {
-	return string(normalize([]byte(d))) == string(normalize([]byte(d2)))
+	return d.Canonical() == d2.Canonical()
 }
 
 // GreaterThan return d > d2
 func (d Decimal) GreaterThan(d2 Decimal) bool {
-	return great(normalize([]byte(d)), normalize([]byte(d2)))
+	return d.Cmp(d2) > 0
 }
 
 // LessThan return d < d2
 func (d Decimal) LessThan(d2 Decimal) bool {
-	return less(normalize([]byte(d)), normalize([]byte(d2)))
+	return d.Cmp(d2) < 0
 }
 
 // GreaterThanOrEqual return d >= d2
 func (d Decimal) GreaterThanOrEqual(d2 Decimal) bool {
-	return !less(normalize([]byte(d)), normalize([]byte(d2)))
+	return d.Cmp(d2) >= 0
 }
 
 // LessThanOrEqual return d <= d2
 func (d Decimal) LessThanOrEqual(d2 Decimal) bool {
-	return !great(normalize([]byte(d)), normalize([]byte(d2)))
+	return d.Cmp(d2) <= 0
 }
 
 // Mul return d * d2
 func (d Decimal) Mul(d2 Decimal) Decimal {
+	if result, ok := mulFast(d, d2); ok {
+		return result
+	}
+
 	return Decimal(mul(normalize([]byte(d)), normalize([]byte(d2))))
 }
 
@@ -641,6 +692,9 @@ func sqr(a []byte, reused ...*[]byte) []byte {
 	}
 
 	a, right := removeDecimalPoint(a)
+	if a[0] == '-' {
+		a = trimFront(a, 1)
+	}
 
 	multiplied := multiplyPureNumber(a, a, reused...)
 	rightSumDigit := right + right
@@ -703,12 +757,52 @@ func removeDecimalPoint(s []byte) (result []byte, countOfRightSide int) {
 	return s, 0
 }
 
+// multiplyBigThreshold is the count of the digits of the shorter operand,
+// from which multiplyBig is faster than multiplySchoolbook.
+//
+// See BenchmarkMultiplyPureNumber for the crossover.
+const multiplyBigThreshold = 32
+
 // multiplyPureNumber return d1 * d2, d1 & d2 must contain only number 0~9
+//
+// It dispatches to multiplySchoolbook for short operands and multiplyBig for long operands.
 func multiplyPureNumber(d1 []byte, d2 []byte, reused ...*[]byte) []byte {
 	if len(d1) < len(d2) {
 		d1, d2 = d2, d1
 	}
 
+	if len(d2) >= multiplyBigThreshold {
+		return multiplyBig(d1, d2)
+	}
+
+	return multiplySchoolbook(d1, d2, reused...)
+}
+
+// multiplyBig return d1 * d2 with big.Int, which uses Karatsuba multiplication for long operands.
+// d1 & d2 must contain only number 0~9
+func multiplyBig(d1 []byte, d2 []byte) []byte {
+	extraCap := 3 // for outside this func to append '-0.'
+
+	var x, y big.Int
+	if _, ok := x.SetString(string(d1), 10); !ok {
+		panic("convert decimal to big int")
+	}
+
+	if _, ok := y.SetString(string(d2), 10); !ok {
+		panic("convert decimal to big int")
+	}
+
+	return x.Mul(&x, &y).Append(make([]byte, 0, len(d1)+len(d2)+extraCap), 10)
+}
+
+// multiplySchoolbook return d1 * d2 with schoolbook multiplication, the operands are swapped
+// so that the outer loop runs over the shorter one.
+// d1 & d2 must contain only number 0~9
+func multiplySchoolbook(d1 []byte, d2 []byte, reused ...*[]byte) []byte {
+	if len(d1) < len(d2) {
+		d1, d2 = d2, d1
+	}
+
 	var (
 		extraCap   = 3 // for outside this func to append '-0.'
 		len1, len2 = len(d1), len(d2)
@@ -773,7 +867,15 @@ func multiplyPureNumber(d1 []byte, d2 []byte, reused ...*[]byte) []byte {
 //
 // return 1 if d > 0, 0 if d == 0, -1 if d < 0
 func (d Decimal) Sign() int {
-	return int(sign(normalize([]byte(d))))
+	c := d.Canonical()
+	switch {
+	case c == _zero:
+		return 0
+	case c[0] == '-':
+		return -1
+	default:
+		return 1
+	}
 }
 
 // Round rounds the decimal to places decimal places.
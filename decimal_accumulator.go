@@ -0,0 +1,264 @@
+package decimal
+
+import "strings"
+
+// Accumulator is a mutable decimal which keeps its digits in an internal growable buffer,
+// so AddInPlace, SubInPlace and MulInPlace reuse the capacity instead of allocating a new Decimal for every operation.
+//
+// The zero value is 0 and ready to use. It's not safe for concurrent use.
+//
+// Example:
+//
+//	var acc decimal.Accumulator
+//	for _, row := range rows {
+//		acc.AddInPlace(row.Amount)
+//	}
+//	total := acc.Decimal()
+type Accumulator struct {
+	neg     bool
+	digits  []byte // the ASCII digits of the absolute value without the decimal point
+	scale   int    // the count of the digits right the decimal point
+	operand []byte // reused by MulInPlace for the digits of the operand
+	scratch []byte // reused by MulInPlace for the product
+}
+
+// NewAccumulator returns an Accumulator starting from the initial value.
+func NewAccumulator(initial Decimal) *Accumulator {
+	a := &Accumulator{}
+	a.Set(initial)
+	return a
+}
+
+// Set sets the value of the accumulator to d, the capacity of the buffer is kept.
+func (a *Accumulator) Set(d Decimal) {
+	o, neg, intLen, scale := accumulatorOperand(d)
+	a.neg, a.scale = neg, scale
+	a.digits = append(a.digits[:0], o[:intLen]...)
+	if scale != 0 {
+		a.digits = append(a.digits, o[intLen+1:]...)
+	}
+
+	a.tidy()
+}
+
+// Reset sets the value of the accumulator to 0, the capacity of the buffer is kept.
+func (a *Accumulator) Reset() {
+	a.neg, a.scale = false, 0
+	a.digits = append(a.digits[:0], '0')
+}
+
+// AddInPlace adds d to the accumulator.
+func (a *Accumulator) AddInPlace(d Decimal) {
+	o, neg, intLen, scale := accumulatorOperand(d)
+	a.add(o, neg, intLen, scale)
+}
+
+// SubInPlace subtracts d from the accumulator.
+func (a *Accumulator) SubInPlace(d Decimal) {
+	o, neg, intLen, scale := accumulatorOperand(d)
+	a.add(o, !neg, intLen, scale)
+}
+
+// MulInPlace multiplies the accumulator by d.
+func (a *Accumulator) MulInPlace(d Decimal) {
+	o, neg, intLen, scale := accumulatorOperand(d)
+	a.init()
+
+	a.operand = append(a.operand[:0], o[:intLen]...)
+	if scale != 0 {
+		a.operand = append(a.operand, o[intLen+1:]...)
+	}
+
+	scale += a.scale
+	product := multiplyPureNumber(a.digits, a.operand, &a.scratch)
+	if isZero(product) {
+		// product may be the shared zeroBytes
+		a.neg, a.scale = false, scale
+		a.digits = pushBackRepeat(a.digits[:0], '0', scale+1)
+		a.tidy()
+		return
+	}
+
+	if len(product) <= scale {
+		product = pushFrontRepeat(product, '0', scale-len(product)+1)
+	}
+
+	a.scratch, a.digits = a.digits[:0], product
+	a.neg, a.scale = a.neg != neg, scale
+	a.tidy()
+}
+
+// Decimal returns the value of the accumulator as a Decimal.
+//
+// Example:
+//
+//	acc := decimal.NewAccumulator(decimal.Require("1.5"))
+//	acc.AddInPlace(decimal.Require("2.25"))
+//	acc.Decimal().String() // "3.75"
+func (a *Accumulator) Decimal() Decimal {
+	a.init()
+
+	intLen := len(a.digits) - a.scale
+	buf := make([]byte, 0, len(a.digits)+2)
+	if a.neg {
+		buf = append(buf, '-')
+	}
+
+	buf = append(buf, a.digits[:intLen]...)
+	if a.scale != 0 {
+		buf = append(buf, '.')
+		buf = append(buf, a.digits[intLen:]...)
+	}
+
+	return Decimal(tidyBytes(buf))
+}
+
+// String returns the string representation of the value of the accumulator.
+func (a *Accumulator) String() string {
+	return a.Decimal().String()
+}
+
+// add adds the operand o, which has intLen digits left the decimal point and scale digits right the decimal point.
+func (a *Accumulator) add(o Decimal, neg bool, intLen, scale int) {
+	accumulate(a, o, neg, intLen, intLen+1, scale)
+}
+
+// addAccumulator adds the value of the accumulator other.
+func (a *Accumulator) addAccumulator(other *Accumulator) {
+	other.init()
+	intLen := len(other.digits) - other.scale
+	accumulate(a, other.digits, other.neg, intLen, intLen, other.scale)
+}
+
+// addProduct adds x * y, scratch is used for the product.
+func (a *Accumulator) addProduct(x, y Decimal, scratch *Accumulator) {
+	scratch.Set(x)
+	scratch.MulInPlace(y)
+	a.addAccumulator(scratch)
+}
+
+// accumulate adds the digits o to the accumulator, o has intLen digits left the decimal point
+// and scale digits right the decimal point which start at fracStart.
+func accumulate[T ~string | ~[]byte](a *Accumulator, o T, neg bool, intLen, fracStart, scale int) {
+	a.init()
+
+	// align the scales and the integer parts
+	if scale > a.scale {
+		a.digits = pushBackRepeat(a.digits, '0', scale-a.scale)
+		a.scale = scale
+	}
+
+	if aIntLen := len(a.digits) - a.scale; intLen > aIntLen {
+		a.digits = pushFrontRepeat(a.digits, '0', intLen-aIntLen)
+	}
+
+	// the index of the digit of a aligned to the first digit of o
+	offset := len(a.digits) - a.scale - intLen
+	digitAt := func(i int) byte {
+		j := i - offset
+		switch {
+		case j < 0 || j >= intLen+scale:
+			return '0'
+		case j < intLen:
+			return o[j]
+		default:
+			return o[j-intLen+fracStart]
+		}
+	}
+
+	if a.neg == neg {
+		var carry byte
+		for i := len(a.digits) - 1; i >= 0; i-- {
+			sum := a.digits[i] - '0' + digitAt(i) - '0' + carry
+			carry = sum / 10
+			a.digits[i] = sum%10 + '0'
+		}
+
+		if carry != 0 {
+			a.digits = pushFront(a.digits, '1')
+		}
+
+		a.tidy()
+		return
+	}
+
+	// subtract the smaller absolute value from the larger one
+	swap := false
+	for i := range a.digits {
+		if c := digitAt(i); c != a.digits[i] {
+			swap = c > a.digits[i]
+			break
+		}
+	}
+
+	var borrow byte
+	for i := len(a.digits) - 1; i >= 0; i-- {
+		x, y := a.digits[i]-'0', digitAt(i)-'0'
+		if swap {
+			x, y = y, x
+		}
+
+		if x < y+borrow {
+			a.digits[i] = x + 10 - y - borrow + '0'
+			borrow = 1
+		} else {
+			a.digits[i] = x - y - borrow + '0'
+			borrow = 0
+		}
+	}
+
+	if swap {
+		a.neg = neg
+	}
+
+	a.tidy()
+}
+
+// init makes the zero value of the accumulator ready to use.
+func (a *Accumulator) init() {
+	if len(a.digits) == 0 {
+		a.Reset()
+	}
+}
+
+// tidy removes the leading zeroes, and the trailing zeroes of the fraction.
+func (a *Accumulator) tidy() {
+	trailing := 0
+	for trailing < a.scale && a.digits[len(a.digits)-1-trailing] == '0' {
+		trailing++
+	}
+
+	a.digits = trimBack(a.digits, trailing)
+	a.scale -= trailing
+
+	leading := 0
+	for leading < len(a.digits)-a.scale-1 && a.digits[leading] == '0' {
+		leading++
+	}
+
+	a.digits = trimFront(a.digits, leading)
+	if isZero(a.digits) {
+		a.neg = false
+	}
+}
+
+// accumulatorOperand returns the absolute value of d in the tidy form, whether it's negative,
+// the count of the digits left the decimal point and the count of the digits right the decimal point.
+//
+// NOTE: NO COPY WHEN d IS TIDY
+func accumulatorOperand(d Decimal) (o Decimal, neg bool, intLen, scale int) {
+	if !isTidy(d, true) {
+		d = Decimal(normalize([]byte(d)))
+	}
+
+	if d[0] == '-' {
+		d, neg = d[1:], true
+	}
+
+	intLen = strings.IndexByte(string(d), '.')
+	if intLen == -1 {
+		return d, neg, len(d), 0
+	}
+
+	return d, neg, intLen, len(d) - intLen - 1
+}
@@ -0,0 +1,81 @@
+package decimal
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func (su *DecimalSuite) TestAccumulator() {
+	r := rand.New(rand.NewSource(34))
+	for i := 0; i < 200; i++ {
+		initial := randomDecimal(r, 25)
+		acc := NewAccumulator(initial)
+		expected := initial
+		for j := 0; j < 20; j++ {
+			d := randomDecimal(r, 25)
+			switch r.Intn(3) {
+			case 0:
+				acc.AddInPlace(d)
+				expected = expected.Add(d)
+			case 1:
+				acc.SubInPlace(d)
+				expected = expected.Sub(d)
+			default:
+				d = d.Truncate(2)
+				acc.MulInPlace(d)
+				expected = expected.Mul(d)
+			}
+
+			su.Require().Equal(expected.String(), acc.Decimal().String(), "step %d with %s", j, d)
+		}
+	}
+}
+
+func (su *DecimalSuite) TestAccumulatorCases() {
+	testCases := []struct {
+		desc     string
+		calc     func(acc *Accumulator)
+		expected string
+	}{
+		{"Zero Value", func(acc *Accumulator) {}, "0"},
+		{"Add To Zero Value", func(acc *Accumulator) { acc.AddInPlace("1.5") }, "1.5"},
+		{"Carry", func(acc *Accumulator) { acc.AddInPlace("999.99"); acc.AddInPlace("0.01") }, "1000"},
+		{"Cross Zero", func(acc *Accumulator) { acc.AddInPlace("1.25"); acc.SubInPlace("3") }, "-1.75"},
+		{"Back To Zero", func(acc *Accumulator) { acc.AddInPlace("-1.25"); acc.AddInPlace("1.25") }, "0"},
+		{"Not Normalized", func(acc *Accumulator) { acc.AddInPlace("+1,000.500"); acc.SubInPlace("-.5") }, "1001"},
+		{"Mul Zero", func(acc *Accumulator) { acc.AddInPlace("-12.5"); acc.MulInPlace("0") }, "0"},
+		{"Mul Fraction", func(acc *Accumulator) { acc.AddInPlace("0.05"); acc.MulInPlace("0.02") }, "0.001"},
+		{"Reset", func(acc *Accumulator) { acc.AddInPlace("12.5"); acc.Reset(); acc.SubInPlace("1") }, "-1"},
+		{"Set", func(acc *Accumulator) { acc.AddInPlace("12.5"); acc.Set("-0.001") }, "-0.001"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			var acc Accumulator
+			tc.calc(&acc)
+			su.Equal(tc.expected, acc.String(), tc.desc)
+		})
+	}
+}
+
+func (su *DecimalSuite) TestSum() {
+	su.Equal("6.75", Sum(Require("1.25"), Require("2.5"), Require("3")).String())
+	su.Equal("-1", Sum(Require("1"), Require("-2")).String())
+	su.Equal("1.5", Sum(Require("1.5")).String())
+	su.Equal("2.25", Avg(Require("1.5"), Require("3")).String())
+}
+
+func TestAccumulatorAllocs(t *testing.T) {
+	values := []Decimal{Require("12.345"), Require("-0.5"), Require("99999.99"), Require("1")}
+	acc := NewAccumulator(Require("1000000000.000"))
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, v := range values {
+			acc.AddInPlace(v)
+			acc.SubInPlace(v)
+		}
+	})
+
+	if allocs != 0 {
+		t.Fatalf("AddInPlace and SubInPlace allocate %v times, want 0", allocs)
+	}
+}
@@ -0,0 +1,118 @@
+package decimal
+
+import (
+	"math/big"
+	"sort"
+)
+
+// Allocate splits the decimal into parts proportional to the ratios, each part has at most places decimal places,
+// and the parts sum exactly to the decimal. The remainder units of 10^(-places) are distributed
+// with the largest remainder method, and the ties go to the earlier ratio.
+//
+// It panics when places is negative, when the decimal has more decimal places than places, when a ratio is negative,
+// or when the ratios sum to 0.
+//
+// Example:
+//
+//	Require("100").Allocate([]Decimal{"1", "1", "1"}, 2)  // ["33.34", "33.33", "33.33"]
+//	Require("0.05").Allocate([]Decimal{"3", "7"}, 2)      // ["0.02", "0.03"], the tie goes to the first
+//	Require("-10").Allocate([]Decimal{"0.5", "0.25"}, 0)  // ["-7", "-3"]
+func (d Decimal) Allocate(ratios []Decimal, places int) []Decimal {
+	if places < 0 {
+		panic("allocate: places must not be negative")
+	}
+
+	if len(ratios) == 0 {
+		return []Decimal{}
+	}
+
+	total, scale := bigIntWithScale(normalize([]byte(d)))
+	if scale > places {
+		panic("allocate: the decimal has more decimal places than places")
+	}
+
+	// the total in the units of 10^(-places)
+	total.Mul(total, pow10(places-scale))
+	neg := total.Sign() < 0
+	total.Abs(total)
+
+	// the ratios as integers with the same scale
+	weights := make([]*big.Int, len(ratios))
+	scales := make([]int, len(ratios))
+	maxScale := 0
+	for i, r := range ratios {
+		weights[i], scales[i] = bigIntWithScale(normalize([]byte(r)))
+		if weights[i].Sign() < 0 {
+			panic("allocate: ratio must not be negative")
+		}
+
+		maxScale = max(maxScale, scales[i])
+	}
+
+	sum := new(big.Int)
+	for i, w := range weights {
+		w.Mul(w, pow10(maxScale-scales[i]))
+		sum.Add(sum, w)
+	}
+
+	if sum.Sign() == 0 {
+		panic("allocate: ratios must not sum to zero")
+	}
+
+	// floor of each share, and the remainder to decide which shares take the leftover units
+	parts := make([]*big.Int, len(weights))
+	remainders := make([]*big.Int, len(weights))
+	leftover := new(big.Int).Set(total)
+	for i, w := range weights {
+		parts[i], remainders[i] = new(big.Int).QuoRem(new(big.Int).Mul(total, w), sum, new(big.Int))
+		leftover.Sub(leftover, parts[i])
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return remainders[order[i]].Cmp(remainders[order[j]]) > 0
+	})
+
+	// leftover is less than the count of the non-zero remainders
+	for i := 0; leftover.Sign() > 0; i++ {
+		parts[order[i]].Add(parts[order[i]], bigOne)
+		leftover.Sub(leftover, bigOne)
+	}
+
+	result := make([]Decimal, len(parts))
+	for i, p := range parts {
+		if neg {
+			p.Neg(p)
+		}
+
+		result[i] = Decimal(tidyBytes(shift([]byte(p.String()), -places)))
+	}
+
+	return result
+}
+
+// Split splits the decimal into n parts which are as equal as possible, each part has at most places decimal places,
+// and the parts sum exactly to the decimal. The earlier parts take the remainder units of 10^(-places).
+//
+// It panics when n is not positive, when places is negative, or when the decimal has more decimal places than places.
+//
+// Example:
+//
+//	Require("100").Split(3, 2) // ["33.34", "33.33", "33.33"]
+//	Require("10").Split(4, 0)  // ["3", "3", "2", "2"]
+func (d Decimal) Split(n int, places int) []Decimal {
+	if n <= 0 {
+		panic("split: n must be positive")
+	}
+
+	ratios := make([]Decimal, n)
+	for i := range ratios {
+		ratios[i] = "1"
+	}
+
+	return d.Allocate(ratios, places)
+}
@@ -0,0 +1,69 @@
+package decimal
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func (su *DecimalSuite) TestAllocate() {
+	testCases := []struct {
+		desc     string
+		d        Decimal
+		ratios   []Decimal
+		places   int
+		expected []string
+	}{
+		{"Three Ways", "100", []Decimal{"1", "1", "1"}, 2, []string{"33.34", "33.33", "33.33"}},
+		{"Tie Goes First", "0.05", []Decimal{"3", "7"}, 2, []string{"0.02", "0.03"}},
+		{"Largest Remainder", "0.05", []Decimal{"0.3", "0.7"}, 2, []string{"0.02", "0.03"}},
+		{"Largest Remainder Last", "10", []Decimal{"1", "2", "4"}, 0, []string{"1", "3", "6"}},
+		{"Negative", "-10", []Decimal{"0.5", "0.25"}, 0, []string{"-7", "-3"}},
+		{"Zero Ratio", "1", []Decimal{"0", "1", "2"}, 2, []string{"0", "0.33", "0.67"}},
+		{"Zero", "0", []Decimal{"1", "2"}, 2, []string{"0", "0"}},
+		{"More Places", "1.5", []Decimal{"1", "2"}, 4, []string{"0.5", "1"}},
+		{"Empty", "1", []Decimal{}, 2, []string{}},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			parts := tc.d.Allocate(tc.ratios, tc.places)
+			actual := make([]string, len(parts))
+			for i, p := range parts {
+				actual[i] = p.String()
+			}
+
+			su.Equal(tc.expected, actual, tc.desc)
+		})
+	}
+
+	su.Panics(func() { Require("1.005").Allocate([]Decimal{"1"}, 2) })
+	su.Panics(func() { Require("1").Allocate([]Decimal{"1", "-1"}, 2) })
+	su.Panics(func() { Require("1").Allocate([]Decimal{"0", "0"}, 2) })
+	su.PanicsWithValue("allocate: places must not be negative", func() { Require("1").Allocate([]Decimal{"1"}, -1) })
+	su.PanicsWithValue("allocate: places must not be negative", func() { Require("100").Allocate([]Decimal{}, -2) })
+}
+
+func (su *DecimalSuite) TestAllocateSum() {
+	r := rand.New(rand.NewSource(44))
+	for i := 0; i < 200; i++ {
+		d := randomDecimal(r, 12).Truncate(3)
+		ratios := make([]Decimal, r.Intn(7)+1)
+		for j := range ratios {
+			ratios[j] = randomDecimal(r, 4).Abs().Add("0.001")
+		}
+
+		parts := d.Allocate(ratios, 3)
+		su.Require().True(Sum(Zero, parts...).Equal(d), "%s %v", d, ratios)
+		for _, p := range parts {
+			su.Require().LessOrEqual(-p.Exponent(), int32(3))
+		}
+	}
+}
+
+func (su *DecimalSuite) TestSplit() {
+	su.Equal([]Decimal{"33.34", "33.33", "33.33"}, Require("100").Split(3, 2))
+	su.Equal([]Decimal{"3", "3", "2", "2"}, Require("10").Split(4, 0))
+	su.Equal([]Decimal{"-0.34", "-0.33", "-0.33"}, Require("-1").Split(3, 2))
+	su.Panics(func() { Require("1").Split(0, 2) })
+	su.PanicsWithValue("allocate: places must not be negative", func() { Require("10").Split(2, -1) })
+}
@@ -0,0 +1,104 @@
+package decimal
+
+import "bytes"
+
+// NewFromBytes returns a new Decimal from a byte slice representation, the same as New
+// without converting the bytes to string first. The byte slice is not modified.
+//
+// Acceptable symbol (+-.,_0123456789)
+//
+// Example:
+//
+//	d, err := decimal.NewFromBytes([]byte("1,000.50"))
+//	d.String() // "1000.5"
+func NewFromBytes(value []byte) (Decimal, error) {
+	if isTidy(value, false) {
+		return Decimal(value), nil
+	}
+
+	buf, err := newDecimal(bytes.Clone(value))
+	if err != nil {
+		return Zero, err
+	}
+
+	return Decimal(buf), nil
+}
+
+// AppendString appends the string representation of the decimal to dst and returns the extended buffer.
+// It doesn't allocate when the decimal is already normalized and dst has enough capacity.
+//
+// Example:
+//
+//	buf := make([]byte, 0, 64)
+//	buf = decimal.Require("-12.345").AppendString(buf) // "-12.345"
+func (d Decimal) AppendString(dst []byte) []byte {
+	if isTidy(d, false) {
+		return append(dst, d...)
+	}
+
+	return append(dst, normalize([]byte(d))...)
+}
+
+// AppendText implements the encoding.TextAppender interface.
+// Unlike AppendString, it returns an error instead of panic when the decimal is invalid.
+func (d Decimal) AppendText(dst []byte) ([]byte, error) {
+	if isTidy(d, false) {
+		return append(dst, d...), nil
+	}
+
+	buf, err := newDecimal([]byte(d))
+	if err != nil {
+		return dst, err
+	}
+
+	return append(dst, buf...), nil
+}
+
+// AppendFixed appends the fixed-point string of the decimal with places digits after the decimal point to dst,
+// the same as StringFixed, and returns the extended buffer.
+// It doesn't allocate when the decimal is already normalized, places is positive and dst has enough capacity.
+//
+// Example:
+//
+//	buf := make([]byte, 0, 64)
+//	buf = decimal.Require("5.45").AppendFixed(buf, 3) // "5.450"
+func (d Decimal) AppendFixed(dst []byte, places int) []byte {
+	if places <= 0 {
+		return append(dst, d.StringFixed(places)...)
+	}
+
+	if !isTidy(d, true) {
+		d = Decimal(normalize([]byte(d)))
+	}
+
+	neg := d[0] == '-'
+	if neg {
+		d = d[1:]
+	}
+
+	intPart, fraction := d, Decimal("")
+	for i := 0; i < len(d); i++ {
+		if d[i] == '.' {
+			intPart, fraction = d[:i], d[i+1:]
+			break
+		}
+	}
+
+	if len(fraction) > places {
+		fraction = fraction[:places]
+	}
+
+	// the truncated zero has no sign
+	if neg && (!isZero(intPart) || !isZero(fraction)) {
+		dst = append(dst, '-')
+	}
+
+	dst = append(dst, intPart...)
+	dst = append(dst, '.')
+	dst = append(dst, fraction...)
+	for i := len(fraction); i < places; i++ {
+		dst = append(dst, '0')
+	}
+
+	return dst
+}
@@ -0,0 +1,88 @@
+package decimal
+
+import (
+	"testing"
+)
+
+func (su *DecimalSuite) TestNewFromBytes() {
+	testCases := []struct {
+		desc     string
+		input    string
+		expected string
+		err      bool
+	}{
+		{"Tidy", "-12.345", "-12.345", false},
+		{"Separator", "+1,000.500", "1000.5", false},
+		{"Zero", "-0.00", "0", false},
+		{"Empty", "", "0", false},
+		{"Invalid", "1.2.3", "", true},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			input := []byte(tc.input)
+			d, err := NewFromBytes(input)
+			su.Equal(tc.input, string(input), "input is modified")
+			if tc.err {
+				su.Error(err, tc.desc)
+				return
+			}
+
+			su.Require().NoError(err, tc.desc)
+			su.Equal(tc.expected, d.String(), tc.desc)
+		})
+	}
+}
+
+func (su *DecimalSuite) TestAppendString() {
+	testCases := []struct {
+		desc  string
+		input Decimal
+	}{
+		{"Tidy", Require("-12.345")},
+		{"Not Normalized", Decimal("+001,000.500")},
+		{"Zero Value", Decimal("")},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			su.Equal("prefix:"+tc.input.String(), string(tc.input.AppendString([]byte("prefix:"))), tc.desc)
+
+			text, err := tc.input.AppendText([]byte("prefix:"))
+			su.Require().NoError(err, tc.desc)
+			su.Equal("prefix:"+tc.input.String(), string(text), tc.desc)
+		})
+	}
+
+	text, err := Decimal("1.2.3").AppendText([]byte("prefix:"))
+	su.Error(err)
+	su.Equal("prefix:", string(text))
+}
+
+func (su *DecimalSuite) TestAppendFixed() {
+	testCases := []struct {
+		input  Decimal
+		places int
+	}{
+		{"5.45", 1}, {"5.45", 3}, {"-5.45", 1}, {"545", 2}, {"0", 2}, {"-0.001", 2},
+		{"-0.001", 3}, {"0.000", 1}, {"1,000.5", 2}, {"545", 0}, {"545", -1}, {"5.45", 0},
+	}
+
+	for _, tc := range testCases {
+		su.Equal(tc.input.StringFixed(tc.places), string(tc.input.AppendFixed(nil, tc.places)), "%s %d", tc.input, tc.places)
+	}
+}
+
+func TestAppendAllocs(t *testing.T) {
+	d := Require("-12345.6789")
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = d.AppendString(buf[:0])
+		buf = d.AppendFixed(buf[:0], 2)
+		buf, _ = d.AppendText(buf[:0])
+	})
+
+	if allocs != 0 {
+		t.Fatalf("append allocates %v times, want 0", allocs)
+	}
+}
@@ -0,0 +1,181 @@
+package decimal
+
+import (
+	"math/rand/v2"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// AtomicDecimal is a decimal which can be updated from multiple goroutines without a lock.
+//
+// The zero value is 0 and ready to use. It must not be copied after first use.
+//
+// Example:
+//
+//	var balance decimal.AtomicDecimal
+//	balance.Add(decimal.Require("10.5"))
+//	balance.Load().String() // "10.5"
+type AtomicDecimal struct {
+	v atomic.Pointer[Decimal]
+}
+
+// NewAtomicDecimal returns an AtomicDecimal with the initial value.
+func NewAtomicDecimal(initial Decimal) *AtomicDecimal {
+	a := &AtomicDecimal{}
+	a.Store(initial)
+	return a
+}
+
+// Load returns the current value.
+func (a *AtomicDecimal) Load() Decimal {
+	return a.load(a.v.Load())
+}
+
+// Store sets the value to d.
+func (a *AtomicDecimal) Store(d Decimal) {
+	a.v.Store(&d)
+}
+
+// Swap sets the value to d and returns the previous value.
+func (a *AtomicDecimal) Swap(d Decimal) (old Decimal) {
+	return a.load(a.v.Swap(&d))
+}
+
+// CompareAndSwap sets the value to new if the current value equals to old by the numeric value,
+// e.g. "1.0" equals to "1", and reports whether the value is swapped.
+func (a *AtomicDecimal) CompareAndSwap(old, new Decimal) (swapped bool) {
+	for {
+		p := a.v.Load()
+		if !a.load(p).Equal(old) {
+			return false
+		}
+
+		// retry when the value is replaced by another goroutine between Load and CompareAndSwap,
+		// the new value may still equal to old.
+		if a.v.CompareAndSwap(p, &new) {
+			return true
+		}
+	}
+}
+
+// Add adds delta to the value with a CompareAndSwap loop and returns the new value.
+//
+// Example:
+//
+//	balance := decimal.NewAtomicDecimal(decimal.Require("100"))
+//	balance.Add(decimal.Require("-0.25")) // "99.75"
+func (a *AtomicDecimal) Add(delta Decimal) (new Decimal) {
+	for {
+		p := a.v.Load()
+		new = a.load(p).Add(delta)
+		if a.v.CompareAndSwap(p, &new) {
+			return new
+		}
+	}
+}
+
+// String returns the string representation of the current value.
+func (a *AtomicDecimal) String() string {
+	return a.Load().String()
+}
+
+func (a *AtomicDecimal) load(p *Decimal) Decimal {
+	if p == nil {
+		return Zero
+	}
+
+	return *p
+}
+
+// ShardedAccumulator is a concurrent accumulator for the high-contention sums,
+// the values are added into one of the shards, and Sum adds up the shards.
+//
+// The zero value has runtime.GOMAXPROCS(0) shards and is ready to use. It must not be copied after first use.
+//
+// Example:
+//
+//	acc := decimal.NewShardedAccumulator(0)
+//	for _, row := range rows {
+//		go acc.Add(row.Amount)
+//	}
+//	total := acc.Sum()
+type ShardedAccumulator struct {
+	once   sync.Once
+	shards []accumulatorShard
+}
+
+type accumulatorShard struct {
+	mu  sync.Mutex
+	acc Accumulator
+	_   [64]byte // avoid false sharing between the shards
+}
+
+// NewShardedAccumulator returns a ShardedAccumulator with the count of the shards,
+// runtime.GOMAXPROCS(0) shards are used when shards is not positive.
+func NewShardedAccumulator(shards int) *ShardedAccumulator {
+	s := &ShardedAccumulator{}
+	s.once.Do(func() { s.init(shards) })
+	return s
+}
+
+// Add adds d to one of the shards. It's safe for concurrent use.
+func (s *ShardedAccumulator) Add(d Decimal) {
+	s.once.Do(func() { s.init(0) })
+
+	// start from a random shard, and take the first shard which isn't locked
+	i := rand.IntN(len(s.shards))
+	for range s.shards {
+		shard := &s.shards[i]
+		if shard.mu.TryLock() {
+			shard.acc.AddInPlace(d)
+			shard.mu.Unlock()
+			return
+		}
+
+		if i++; i == len(s.shards) {
+			i = 0
+		}
+	}
+
+	shard := &s.shards[i]
+	shard.mu.Lock()
+	shard.acc.AddInPlace(d)
+	shard.mu.Unlock()
+}
+
+// Sum returns the sum of the shards. It's safe for concurrent use with Add,
+// but the values added during Sum may or may not be included.
+func (s *ShardedAccumulator) Sum() Decimal {
+	s.once.Do(func() { s.init(0) })
+
+	var total Accumulator
+	for i := range s.shards {
+		shard := &s.shards[i]
+		shard.mu.Lock()
+		total.addAccumulator(&shard.acc)
+		shard.mu.Unlock()
+	}
+
+	return total.Decimal()
+}
+
+// Reset sets all the shards to 0.
+func (s *ShardedAccumulator) Reset() {
+	s.once.Do(func() { s.init(0) })
+
+	for i := range s.shards {
+		shard := &s.shards[i]
+		shard.mu.Lock()
+		shard.acc.Reset()
+		shard.mu.Unlock()
+	}
+}
+
+func (s *ShardedAccumulator) init(shards int) {
+	if shards <= 0 {
+		shards = runtime.GOMAXPROCS(0)
+	}
+
+	s.shards = make([]accumulatorShard, shards)
+}
@@ -0,0 +1,83 @@
+package decimal
+
+import (
+	"sync"
+)
+
+func (su *DecimalSuite) TestAtomicDecimal() {
+	var a AtomicDecimal
+	su.Equal("0", a.Load().String())
+
+	a.Store(Require("1.5"))
+	su.Equal("1.5", a.String())
+
+	su.Equal("1.5", a.Swap(Require("2")).String())
+	su.Equal("2", a.Load().String())
+
+	su.True(a.CompareAndSwap(Decimal("2.00"), Require("3")), "compare by the numeric value")
+	su.False(a.CompareAndSwap(Require("2"), Require("4")))
+	su.Equal("3", a.Load().String())
+
+	su.Equal("2.75", a.Add(Require("-0.25")).String())
+	su.Equal("2.75", NewAtomicDecimal(Require("2.75")).Load().String())
+}
+
+func (su *DecimalSuite) TestAtomicDecimalConcurrent() {
+	var (
+		a  AtomicDecimal
+		s  = NewShardedAccumulator(4)
+		zs ShardedAccumulator
+		wg sync.WaitGroup
+	)
+
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				a.Add(Require("0.01"))
+				s.Add(Require("0.01"))
+				zs.Add(Require("-0.5"))
+			}
+		}()
+	}
+
+	wg.Wait()
+	su.Equal("40", a.Load().String())
+	su.Equal("40", s.Sum().String())
+	su.Equal("-2000", zs.Sum().String())
+
+	s.Reset()
+	su.Equal("0", s.Sum().String())
+}
+
+func (su *DecimalSuite) TestAtomicDecimalCompareAndSwapConcurrent() {
+	var (
+		a       = NewAtomicDecimal(Zero)
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		swapped int
+	)
+
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				for {
+					cur := a.Load()
+					if a.CompareAndSwap(cur, cur.Add(Require("1"))) {
+						mu.Lock()
+						swapped++
+						mu.Unlock()
+						break
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	su.Equal(800, swapped)
+	su.Equal("800", a.Load().String())
+}
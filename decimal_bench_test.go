@@ -1,7 +1,10 @@
 package decimal
 
 import (
+	"fmt"
+	"math/big"
 	"runtime"
+	"sync"
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -101,6 +104,27 @@ func BenchmarkStringFixed(b *testing.B) {
 	)
 }
 
+func BenchmarkAppendFixed(b *testing.B) {
+	Run(b,
+		func(b *testing.B) {
+			d1, _ := decimal.NewFromString(_operatorBase)
+			buf := make([]byte, 0, 64)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				buf = append(buf[:0], d1.StringFixed(2)...)
+			}
+		},
+		func(b *testing.B) {
+			d1, _ := New(_operatorBase)
+			buf := make([]byte, 0, 64)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				buf = d1.AppendFixed(buf[:0], 2)
+			}
+		},
+	)
+}
+
 func BenchmarkAbs(b *testing.B) {
 	Run(b,
 		func(b *testing.B) {
@@ -591,6 +615,31 @@ func BenchmarkPow(b *testing.B) {
 	)
 }
 
+func BenchmarkPowLarge(b *testing.B) {
+	for _, exp := range []string{"10", "50", "200"} {
+		b.Run(exp, func(b *testing.B) {
+			Run(b,
+				func(b *testing.B) {
+					d1, _ := decimal.NewFromString("1.00456888")
+					d2, _ := decimal.NewFromString(exp)
+					b.ResetTimer()
+					for i := 0; i < b.N; i++ {
+						_ = d1.Pow(d2)
+					}
+				},
+				func(b *testing.B) {
+					d1, _ := New("1.00456888")
+					d2, _ := New(exp)
+					b.ResetTimer()
+					for i := 0; i < b.N; i++ {
+						_ = d1.Pow(d2)
+					}
+				},
+			)
+		})
+	}
+}
+
 func BenchmarkMul(b *testing.B) {
 	Run(b,
 		func(b *testing.B) {
@@ -610,6 +659,68 @@ func BenchmarkMul(b *testing.B) {
 	)
 }
 
+func benchmarkDigits(n int, seed byte) []byte {
+	buf := make([]byte, n)
+	for i := range buf {
+		buf[i] = '1' + (byte(i)*7+seed)%9
+	}
+
+	return buf
+}
+
+func BenchmarkMultiplyPureNumber(b *testing.B) {
+	for _, n := range []int{16, 32, 64, 100, 128, 256, 512, 1024} {
+		d1, d2 := benchmarkDigits(n, 3), benchmarkDigits(n, 5)
+
+		b.Run(fmt.Sprintf("Schoolbook/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = multiplySchoolbook(d1, d2)
+			}
+		})
+
+		b.Run(fmt.Sprintf("BigInt/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = multiplyBig(d1, d2)
+			}
+		})
+	}
+}
+
+// BenchmarkMulCrossover measures Mul on the both sides of multiplyBigThreshold,
+// Pow doesn't reach the dispatch because it computes the positive power with big.Int directly.
+func BenchmarkMulCrossover(b *testing.B) {
+	for _, n := range []int{multiplyBigThreshold - 1, multiplyBigThreshold} {
+		d1, d2 := Decimal(benchmarkDigits(n, 3)), Decimal(benchmarkDigits(n, 5))
+
+		b.Run(fmt.Sprintf("%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = d1.Mul(d2)
+			}
+		})
+	}
+}
+
+func BenchmarkMulSmall(b *testing.B) {
+	Run(b,
+		func(b *testing.B) {
+			d1, _ := decimal.NewFromString("1289.05")
+			d2, _ := decimal.NewFromString("3.25")
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = d1.Mul(d2).Add(d1)
+			}
+		},
+		func(b *testing.B) {
+			d1, _ := New("1289.05")
+			d2, _ := New("3.25")
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = d1.Mul(d2).Add(d1)
+			}
+		},
+	)
+}
+
 func BenchmarkDiv(b *testing.B) {
 	Run(b,
 		func(b *testing.B) {
@@ -647,3 +758,200 @@ func BenchmarkMod(b *testing.B) {
 		},
 	)
 }
+
+func BenchmarkSum(b *testing.B) {
+	values := make([]string, 1000)
+	for i := range values {
+		values[i] = fmt.Sprintf("%d.%02d", i*37%1000, i%100)
+	}
+
+	b.Run("ShopSpringDecimal", func(b *testing.B) {
+		ds := make([]decimal.Decimal, len(values))
+		for i, v := range values {
+			ds[i] = decimal.RequireFromString(v)
+		}
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_ = decimal.Sum(ds[0], ds[1:]...)
+		}
+	})
+
+	ds := make([]Decimal, len(values))
+	for i, v := range values {
+		ds[i] = Require(v)
+	}
+
+	b.Run("Add", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			summed := ds[0]
+			for _, d := range ds[1:] {
+				summed = summed.Add(d)
+			}
+		}
+	})
+
+	b.Run("Accumulator", func(b *testing.B) {
+		var acc Accumulator
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			acc.Reset()
+			for _, d := range ds {
+				acc.AddInPlace(d)
+			}
+			_ = acc.Decimal()
+		}
+	})
+}
+
+func BenchmarkDot(b *testing.B) {
+	qty, price := make([]Decimal, 100_000), make([]Decimal, 100_000)
+	for i := range qty {
+		qty[i] = Require(fmt.Sprintf("%d.%03d", i%1000, i%997))
+		price[i] = Require(fmt.Sprintf("%d.%02d", i%89, i%100))
+	}
+
+	b.Run("MulAdd", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			summed := Zero
+			for j := range qty {
+				summed = summed.Add(qty[j].Mul(price[j]))
+			}
+		}
+	})
+
+	b.Run("Dot", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = Dot(qty, price)
+		}
+	})
+
+	b.Run("DotParallel", func(b *testing.B) {
+		opts := SliceOptions{ParallelThreshold: 10_000}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = opts.Dot(qty, price)
+		}
+	})
+}
+
+// rwMutexPow10 is the previous design of pow10, which is kept for comparison in BenchmarkPow10Parallel.
+type rwMutexPow10 struct {
+	mu    sync.RWMutex
+	table []*big.Int
+}
+
+func (c *rwMutexPow10) get(n int) *big.Int {
+	c.mu.RLock()
+	if n < len(c.table) {
+		v := c.table[n]
+		c.mu.RUnlock()
+		return v
+	}
+	c.mu.RUnlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for len(c.table) <= n {
+		c.table = append(c.table, new(big.Int).Mul(c.table[len(c.table)-1], big.NewInt(10)))
+	}
+	return c.table[n]
+}
+
+func BenchmarkPow10Parallel(b *testing.B) {
+	b.Run("RWMutex", func(b *testing.B) {
+		c := &rwMutexPow10{table: []*big.Int{big.NewInt(1)}}
+		b.RunParallel(func(pb *testing.PB) {
+			n := 0
+			for pb.Next() {
+				_ = c.get(n % 100)
+				n++
+			}
+		})
+	})
+
+	b.Run("LockFree", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			n := 0
+			for pb.Next() {
+				_ = pow10(n % 100)
+				n++
+			}
+		})
+	})
+}
+
+func BenchmarkDivParallel(b *testing.B) {
+	d1, d2 := Require("1234567890123456789012.345"), Require("-98765432109876543210987.6")
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = d1.Div(d2)
+		}
+	})
+}
+
+func BenchmarkConcurrentSum(b *testing.B) {
+	delta := Require("0.01")
+
+	b.Run("Mutex", func(b *testing.B) {
+		var (
+			mu  sync.Mutex
+			sum Decimal
+		)
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				mu.Lock()
+				sum = sum.Add(delta)
+				mu.Unlock()
+			}
+		})
+	})
+
+	b.Run("AtomicDecimal", func(b *testing.B) {
+		var sum AtomicDecimal
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				sum.Add(delta)
+			}
+		})
+	})
+
+	b.Run("ShardedAccumulator", func(b *testing.B) {
+		sum := NewShardedAccumulator(0)
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				sum.Add(delta)
+			}
+		})
+	})
+}
+
+func BenchmarkDivPath(b *testing.B) {
+	d1, d2 := normalize([]byte(_operatorBase)), normalize([]byte(_operatorAddition))
+	dp := getDivisionPrecision()
+
+	b.Run("Long", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = divLong(d1, d2, dp)
+		}
+	})
+
+	b.Run("BigInt", func(b *testing.B) {
+		a1, a2 := make([]byte, len(d1)), make([]byte, len(d2))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			// divBig removes the decimal point in place
+			copy(a1, d1)
+			copy(a2, d2)
+			_ = divBig(a1, a2, dp)
+		}
+	})
+}
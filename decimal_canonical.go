@@ -0,0 +1,192 @@
+package decimal
+
+import (
+	"cmp"
+	"hash/maphash"
+	"strings"
+)
+
+// Canonical returns the canonical form of the decimal, which has no sign of zero, no leading zeroes
+// and no trailing zeroes of the fraction. Decimals with the same value have the same canonical form,
+// so the canonical form can be compared with == and used as a map key.
+//
+// It doesn't allocate when the decimal is already normalized.
+//
+// Example:
+//
+//	Decimal("1.50").Canonical()       // "1.5"
+//	Decimal("+001,000.0").Canonical() // "1000"
+//	Decimal("-0.00").Canonical()      // "0"
+func (d Decimal) Canonical() Decimal {
+	if isTidy(d, true) {
+		return trimScale(d)
+	}
+
+	return Decimal(normalize([]byte(d)))
+}
+
+// IsCanonical reports whether the decimal is already in the canonical form.
+// It doesn't allocate.
+//
+// Example:
+//
+//	Decimal("1.5").IsCanonical()  // true
+//	Decimal("1.50").IsCanonical() // false
+//	Decimal("+1.5").IsCanonical() // false
+func (d Decimal) IsCanonical() bool {
+	return isTidy(d, false)
+}
+
+// IsValid reports whether the decimal represents a valid number, which can be used without panic.
+// The zero value is valid.
+//
+// Example:
+//
+//	Decimal("1,000.50").IsValid() // true
+//	Decimal("").IsValid()         // true
+//	Decimal("1.2.3").IsValid()    // false
+func (d Decimal) IsValid() bool {
+	if isTidy(d, true) {
+		return true
+	}
+
+	_, err := newDecimal([]byte(d))
+	return err == nil
+}
+
+// Hash returns the hash of the decimal value with the seed.
+// Decimals with the same value have the same hash, e.g. "1.0" and "1".
+func (d Decimal) Hash(seed maphash.Seed) uint64 {
+	return maphash.String(seed, string(d.Canonical()))
+}
+
+// compareCanonical compares two decimals in the canonical form and returns:
+//
+//	-1 if a <  b
+//	 0 if a == b
+//	+1 if a >  b
+//
+//	example: 1234.001 vs 12.00001
+//	the integer part of the longer one is greater, otherwise they are compared lexicographically
+//	because the dots are in the same position.
+func compareCanonical(a, b Decimal) int {
+	aNeg, bNeg := a[0] == '-', b[0] == '-'
+	if aNeg != bNeg {
+		if aNeg {
+			return -1
+		}
+		return 1
+	}
+
+	result := 0
+	if aNeg {
+		a, b = a[1:], b[1:]
+	}
+
+	aDotIdx, bDotIdx := strings.IndexByte(string(a), '.'), strings.IndexByte(string(b), '.')
+	if aDotIdx == -1 {
+		aDotIdx = len(a)
+	}
+	if bDotIdx == -1 {
+		bDotIdx = len(b)
+	}
+
+	switch {
+	case aDotIdx < bDotIdx:
+		result = -1
+	case aDotIdx > bDotIdx:
+		result = 1
+	default:
+		// the operators don't let a and b escape, unlike strings.Compare
+		result = cmp.Compare(a, b)
+	}
+
+	if aNeg {
+		return -result
+	}
+
+	return result
+}
+
+// isTidy reports whether s is in the form produced by tidyBytes,
+// the trailing zeroes of the fraction are allowed when keepScale is true.
+//
+// NOTE: NO COPY
+func isTidy[T ~string | ~[]byte](s T, keepScale bool) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	i := 0
+	neg := s[0] == '-'
+	if neg {
+		i++
+	}
+
+	// integer part
+	if i >= len(s) || s[i] < '0' || s[i] > '9' {
+		return false
+	}
+
+	nonZero := s[i] != '0'
+	if !nonZero && i+1 < len(s) && s[i+1] != '.' {
+		return false
+	}
+
+	for i++; i < len(s) && s[i] != '.'; i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	// fraction part
+	if i < len(s) {
+		if i == len(s)-1 {
+			return false
+		}
+
+		for i++; i < len(s); i++ {
+			if s[i] < '0' || s[i] > '9' {
+				return false
+			}
+
+			if s[i] != '0' {
+				nonZero = true
+			}
+		}
+
+		if !keepScale && s[len(s)-1] == '0' {
+			return false
+		}
+	}
+
+	return !neg || nonZero
+}
+
+// trimScale removes the trailing zeroes of the fraction of a tidy decimal.
+//
+// NOTE: NO COPY
+func trimScale[T ~string | ~[]byte](s T) T {
+	hasDot := false
+	for i := 0; i < len(s); i++ {
+		if s[i] == '.' {
+			hasDot = true
+			break
+		}
+	}
+
+	if !hasDot {
+		return s
+	}
+
+	end := len(s)
+	for s[end-1] == '0' {
+		end--
+	}
+
+	if s[end-1] == '.' {
+		end--
+	}
+
+	return s[:end]
+}
@@ -0,0 +1,159 @@
+package decimal
+
+import (
+	"hash/maphash"
+	"testing"
+)
+
+func (su *DecimalSuite) TestCanonical() {
+	testCases := []struct {
+		desc     string
+		input    Decimal
+		expected Decimal
+	}{
+		{"Canonical", Decimal("1.5"), "1.5"},
+		{"Trailing Zero", Decimal("1.50"), "1.5"},
+		{"Trailing Zero Integer", Decimal("100.00"), "100"},
+		{"Leading Zero", Decimal("001.5"), "1.5"},
+		{"Plus", Decimal("+1.5"), "1.5"},
+		{"Separator", Decimal("1,000.0"), "1000"},
+		{"Leading Dot", Decimal("-.5"), "-0.5"},
+		{"Negative Zero", Decimal("-0.00"), "0"},
+		{"Zero", Decimal("0"), "0"},
+		{"Empty", Decimal(""), "0"},
+		{"Quoted", Decimal(`"1.20"`), "1.2"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			su.Equal(tc.expected, tc.input.Canonical(), tc.desc)
+		})
+	}
+
+	su.Panics(func() { Decimal("1.2.3").Canonical() })
+}
+
+func (su *DecimalSuite) TestCanonicalAllocation() {
+	for _, d := range []Decimal{"1.5", "-1.50", "0.00", "100"} {
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = d.Canonical()
+		})
+		su.Zero(allocs, string(d))
+	}
+}
+
+func (su *DecimalSuite) TestIsTidy() {
+	testCases := []struct {
+		input     string
+		tidy      bool
+		tidyScale bool
+	}{
+		{"0", true, true},
+		{"1", true, true},
+		{"-1", true, true},
+		{"1.5", true, true},
+		{"-0.5", true, true},
+		{"1.50", false, true},
+		{"0.00", false, true},
+		{"", false, false},
+		{"-", false, false},
+		{"-0", false, false},
+		{"-0.00", false, false},
+		{"00", false, false},
+		{"01", false, false},
+		{"+1", false, false},
+		{".5", false, false},
+		{"1.", false, false},
+		{"1.2.3", false, false},
+		{"1,000", false, false},
+		{"1a", false, false},
+	}
+
+	for _, tc := range testCases {
+		su.Equal(tc.tidy, isTidy(tc.input, false), tc.input)
+		su.Equal(tc.tidyScale, isTidy(tc.input, true), tc.input)
+	}
+}
+
+func (su *DecimalSuite) TestHash() {
+	seed := maphash.MakeSeed()
+	su.Equal(Decimal("1").Hash(seed), Decimal("1.00").Hash(seed))
+	su.Equal(Decimal("-0.5").Hash(seed), Decimal("-.50").Hash(seed))
+	su.Equal(Decimal("0").Hash(seed), Decimal("-0").Hash(seed))
+	su.NotEqual(Decimal("1").Hash(seed), Decimal("-1").Hash(seed))
+}
+
+func (su *DecimalSuite) TestIsCanonicalAndIsValid() {
+	testCases := []struct {
+		input     Decimal
+		canonical bool
+		valid     bool
+	}{
+		{"1.5", true, true},
+		{"-1.5", true, true},
+		{"0", true, true},
+		{"", false, true},
+		{"1.50", false, true},
+		{"+1.5", false, true},
+		{"1,000", false, true},
+		{"-0", false, true},
+		{"1.2.3", false, false},
+		{"1a", false, false},
+		{"+", false, false},
+	}
+
+	for _, tc := range testCases {
+		su.Equal(tc.canonical, tc.input.IsCanonical(), string(tc.input))
+		su.Equal(tc.valid, tc.input.IsValid(), string(tc.input))
+	}
+}
+
+func (su *DecimalSuite) TestCanonicalFastPathAllocation() {
+	d1, d2, neg := Require("12789.00456888"), Require("789.00456888"), Require("-1.5")
+	testCases := []struct {
+		desc string
+		f    func()
+	}{
+		{"String", func() { _ = d1.String() }},
+		{"Abs", func() { _ = neg.Abs() }},
+		{"Abs Positive", func() { _ = d1.Abs() }},
+		{"Neg Negative", func() { _ = neg.Neg() }},
+		{"Cmp", func() { _ = d1.Cmp(d2) }},
+		{"Equal", func() { _ = d1.Equal(d2) }},
+		{"GreaterThan", func() { _ = d1.GreaterThan(d2) }},
+		{"Sign", func() { _ = neg.Sign() }},
+		{"IsZero", func() { _ = d1.IsZero() }},
+		{"IsCanonical", func() { _ = d1.IsCanonical() }},
+		{"IsValid", func() { _ = d1.IsValid() }},
+	}
+
+	for _, tc := range testCases {
+		su.Zero(testing.AllocsPerRun(100, tc.f), tc.desc)
+	}
+
+	// the negation of a positive decimal is a new string
+	var sink Decimal
+	su.Equal(1.0, testing.AllocsPerRun(100, func() { sink = d1.Neg() }), "Neg Positive")
+	su.Equal("-12789.00456888", sink.String())
+}
+
+func (su *DecimalSuite) TestCompareCanonical() {
+	testCases := []struct {
+		a, b     Decimal
+		expected int
+	}{
+		{"1234.001", "12.00001", 1},
+		{"12.5", "12.45", 1},
+		{"12", "12.1", -1},
+		{"0", "0.5", -1},
+		{"0", "-0.5", 1},
+		{"-12", "-12.1", 1},
+		{"-1234.001", "-12.00001", -1},
+		{"1.5", "1.5", 0},
+	}
+
+	for _, tc := range testCases {
+		su.Equal(tc.expected, compareCanonical(tc.a, tc.b), "%s vs %s", tc.a, tc.b)
+		su.Equal(-tc.expected, compareCanonical(tc.b, tc.a), "%s vs %s", tc.b, tc.a)
+	}
+}
@@ -2,22 +2,21 @@ package decimal
 
 import (
 	"math/big"
-	"sync"
+	"math/bits"
+	"sync/atomic"
 )
 
-// Div2 returns d / d2 with better performance compared with Div.
+// Div returns d / d2 with DivisionPrecision decimal places.
+// The remainder is kept non-negative the same as big.Int.Div, so the result is truncated toward zero
+// when d is not negative, and an inexact result is rounded away from zero when d is negative.
 //
-// The algorithm:
+// Example:
 //
-//	Suppose d  -> A / 10^iShift,  d2 -> B / 10^i2Shift.
-//	We want result scaled to DivisionPrecision decimal digits.
-//	Compute shiftExp = DivisionPrecision + i2Shift - iShift.
-//	If shiftExp >= 0:
-//	    resultInt = (A * 10^shiftExp) / B
-//	else:
-//	    resultInt = A / (B * 10^{-shiftExp})
-//	The scaled integer is then shifted back by DivisionPrecision using the
-//	existing shift helper.
+//	Require("1").Div(Require("3")).String()  // "0.3333333333333333"
+//	Require("-1").Div(Require("3")).String() // "-0.3333333333333334"
+//
+// The divisor up to 19 significant digits is divided with the native long division on the digits,
+// otherwise it falls back to big.Int.
 func (d Decimal) Div(d2 Decimal) Decimal {
 	return Decimal(div(normalize([]byte(d)), normalize([]byte(d2))))
 }
@@ -35,6 +34,179 @@ func div(a, b []byte) []byte {
 		return zeroBytes
 	}
 
+	dp := getDivisionPrecision()
+	if result, ok := divLong(a, b, dp); ok {
+		return result
+	}
+
+	return divBig(a, b, dp)
+}
+
+// maxLongDivisorDigits is the max count of the significant digits of the divisor handled by divLong,
+// 10^19 - 1 always fits in an uint64.
+const maxLongDivisorDigits = 19
+
+// divLong returns a / b to dp decimal places with the long division on the digits,
+// which takes the numerator up to 18 digits at a time and keeps the remainder in an uint64.
+// It allocates only the result. The result is rounded the same as divBig, the magnitude of
+// an inexact quotient is truncated and increased by one unit of the last place when a is negative.
+//
+// ok is false when the divisor has more than maxLongDivisorDigits significant digits,
+// the caller should fall back to divBig.
+//
+//	Suppose a -> A / 10^aScale, b -> B / 10^bScale.
+//	The result is trunc(A * 10^shiftExp / B) / 10^dp, where shiftExp = dp + bScale - aScale.
+//	If shiftExp < 0, the last -shiftExp digits of A are dropped instead,
+//	because trunc(trunc(A / 10^k) / B) == trunc(A / (B * 10^k)).
+//
+// NOTE: NO COPY
+func divLong(a, b []byte, dp int) (result []byte, ok bool) {
+	neg, aNeg := false, a[0] == '-'
+	if aNeg {
+		a = a[1:]
+		neg = !neg
+	}
+
+	if b[0] == '-' {
+		b = b[1:]
+		neg = !neg
+	}
+
+	var divisor uint64
+	bScale, bDigits := -1, 0
+	for _, c := range b {
+		if c == '.' {
+			bScale = 0
+			continue
+		}
+
+		if bScale >= 0 {
+			bScale++
+		}
+
+		if bDigits == 0 && c == '0' {
+			continue
+		}
+
+		bDigits++
+		if bDigits > maxLongDivisorDigits {
+			return nil, false
+		}
+
+		divisor = divisor*10 + uint64(c-'0')
+	}
+
+	aDotIdx := findDotIndex(a)
+	aLen, aScale := len(a), 0
+	if aDotIdx != -1 {
+		aLen--
+		aScale = aLen - aDotIdx
+	} else {
+		aDotIdx = len(a)
+	}
+
+	// the numerator is the digits of a followed by shiftExp zeroes, or without the last -shiftExp digits
+	n := aLen + dp + max(bScale, 0) - aScale
+	if n <= 0 {
+		if aNeg {
+			// the quotient is one unit of the last place, leave it to divBig
+			return nil, false
+		}
+
+		return zeroBytes, true
+	}
+
+	// layout: sign, integer digits with a leading zero for the carry of rounding, '.', dp fraction digits
+	width := max(n, dp+1) + 1
+	intLen := width - dp
+	buf := make([]byte, width+2)
+	for i := range buf {
+		buf[i] = '0'
+	}
+	buf[1+intLen] = '.'
+
+	var (
+		rem   uint64
+		chunk uint64
+		k     int
+		pos   = width - n // the index of the next quotient digit, counted in digits
+	)
+
+	for i := 0; i < n; i++ {
+		digit := byte('0')
+		if i < aLen {
+			if i < aDotIdx {
+				digit = a[i]
+			} else {
+				digit = a[i+1]
+			}
+		}
+
+		chunk = chunk*10 + uint64(digit-'0')
+		k++
+		if k < maxFastDigits && i != n-1 {
+			continue
+		}
+
+		hi, lo := bits.Mul64(rem, uint64(pow10Int64[k]))
+		lo, carry := bits.Add64(lo, chunk, 0)
+		var q uint64
+		q, rem = bits.Div64(hi+carry, lo, divisor)
+
+		// write k digits of q, padded with zeroes
+		for j := pos + k - 1; j >= pos; j-- {
+			idx := 1 + j
+			if j >= intLen {
+				idx++
+			}
+
+			buf[idx] = byte(q%10) + '0'
+			q /= 10
+		}
+
+		pos += k
+		chunk, k = 0, 0
+	}
+
+	// the dropped digits of a are in the fraction, they exist only when shiftExp < 0
+	if aNeg && (rem != 0 || n < aLen && !isZero(a[n+1:])) {
+		// add one unit of the last place
+		for i := len(buf) - 1; ; i-- {
+			if buf[i] == '.' {
+				continue
+			}
+
+			if buf[i] != '9' {
+				buf[i]++
+				break
+			}
+
+			buf[i] = '0'
+		}
+	}
+
+	if neg {
+		buf[0] = '-'
+	} else {
+		buf = buf[1:]
+	}
+
+	return tidyBytes(buf), true
+}
+
+// divBig returns a / b to dp decimal places with big.Int, which handles any divisor.
+// The remainder of big.Int.Div is non-negative, so the quotient is floored when b is positive and ceiled when b is negative.
+//
+// The algorithm:
+//
+//	Suppose a -> A / 10^iShift, b -> B / 10^i2Shift.
+//	Compute shiftExp = dp + i2Shift - iShift.
+//	If shiftExp >= 0:
+//	    resultInt = (A * 10^shiftExp) / B
+//	else:
+//	    resultInt = A / (B * 10^{-shiftExp})
+//	The scaled integer is then shifted back by dp using the existing shift helper.
+func divBig(a, b []byte, dp int) []byte {
 	// Remove decimal point to get pure integer representations
 	ib, iShift := removeDecimalPoint(a)
 	ib2, i2Shift := removeDecimalPoint(b)
@@ -49,8 +221,7 @@ func div(a, b []byte) []byte {
 		panic("convert decimal to big int")
 	}
 
-	dp := getDivisionPrecision()
-	// Calculate scaling factor to preserve DivisionPrecision digits
+	// Calculate scaling factor to preserve dp digits
 	shiftExp := dp + i2Shift - iShift
 
 	// Scale numerator or denominator accordingly
@@ -71,42 +242,66 @@ func div(a, b []byte) []byte {
 
 // ------------------------- helper -------------------------
 
+// pow10StaticSize is the count of the precomputed powers of ten,
+// which covers DivisionPrecision plus the scales of the common operands.
+const pow10StaticSize = 64
+
 var (
-	pow10Once  sync.Once
-	pow10Table []*big.Int
-	pow10Mu    sync.RWMutex
+	// pow10Static is the read-only table of 10^0 ~ 10^(pow10StaticSize-1).
+	pow10Static = initPow10Static()
+	// pow10Grown is the copy-on-write table of 10^pow10StaticSize and above,
+	// it's replaced by a longer copy instead of being modified.
+	pow10Grown atomic.Pointer[[]*big.Int]
 )
 
-// initPow10Table initializes the power-of-ten lookup with 0 -> 1.
-func initPow10Table() {
-	pow10Table = []*big.Int{big.NewInt(1)}
+// initPow10Static computes the powers of ten of the static table.
+func initPow10Static() *[pow10StaticSize]*big.Int {
+	var table [pow10StaticSize]*big.Int
+	table[0] = big.NewInt(1)
+	for i := 1; i < len(table); i++ {
+		table[i] = new(big.Int).Mul(table[i-1], big.NewInt(10))
+	}
+
+	return &table
 }
 
-// pow10 returns 10^n using a shared cache to avoid repetitive Exp calculations.
+// pow10 returns 10^n from the shared cache without locking, the result must not be modified.
 func pow10(n int) *big.Int {
 	if n < 0 {
 		panic("pow10: negative exponent")
 	}
 
-	// Ensure the table is initialized exactly once.
-	pow10Once.Do(initPow10Table)
-
-	pow10Mu.RLock()
-	if n < len(pow10Table) {
-		v := pow10Table[n]
-		pow10Mu.RUnlock()
-		return v
+	if n < pow10StaticSize {
+		return pow10Static[n]
 	}
-	pow10Mu.RUnlock()
 
-	// Upgrade lock to write
-	pow10Mu.Lock()
-	defer pow10Mu.Unlock()
+	idx := n - pow10StaticSize
+	for {
+		p := pow10Grown.Load()
+		var grown []*big.Int
+		if p != nil {
+			if idx < len(*p) {
+				return (*p)[idx]
+			}
+
+			grown = *p
+		}
+
+		// copy and grow the table, retry when another goroutine has replaced it
+		next := make([]*big.Int, len(grown), idx+1)
+		copy(next, grown)
+		last := pow10Static[pow10StaticSize-1]
+		if len(next) != 0 {
+			last = next[len(next)-1]
+		}
+
+		for len(next) <= idx {
+			last = new(big.Int).Mul(last, big.NewInt(10))
+			next = append(next, last)
+		}
 
-	// Re-check to avoid duplicate work after lock upgrade
-	for len(pow10Table) <= n {
-		next := new(big.Int).Mul(pow10Table[len(pow10Table)-1], big.NewInt(10))
-		pow10Table = append(pow10Table, next)
+		if pow10Grown.CompareAndSwap(p, &next) {
+			return next[idx]
+		}
 	}
-	return pow10Table[n]
 }
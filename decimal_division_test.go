@@ -0,0 +1,94 @@
+package decimal
+
+import (
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func (su *DecimalSuite) TestDivLong() {
+	r := rand.New(rand.NewSource(33))
+	for _, dp := range []int{0, 1, DivisionPrecision, 40} {
+		for i := 0; i < 2000; i++ {
+			a, b := randomDecimal(r, 30), randomDecimal(r, maxLongDivisorDigits)
+			if b.IsZero() || a.IsZero() {
+				continue
+			}
+
+			result, ok := divLong(normalize([]byte(a)), normalize([]byte(b)), dp)
+			su.Require().True(ok, "%s / %s", a, b)
+			expected := divBig(normalize([]byte(a)), normalize([]byte(b)), dp)
+			su.Require().Equal(string(expected), string(result), "%s / %s, dp %d", a, b, dp)
+		}
+	}
+}
+
+func (su *DecimalSuite) TestDivRounding() {
+	testCases := []struct {
+		desc     string
+		d1, d2   Decimal
+		expected string
+	}{
+		{"Positive", "1", "3", "0.3333333333333333"},
+		{"Negative Dividend", "-1", "3", "-0.3333333333333334"},
+		{"Negative Divisor", "1", "-3", "-0.3333333333333333"},
+		{"Both Negative", "-2", "-3", "0.6666666666666667"},
+		{"Negative Exact", "-1", "4", "-0.25"},
+		{"Negative Carry", "-0.99999999999999999", "1", "-1"},
+		{"Negative Carry Integer", "-99.99999999999999999", "-1", "100"},
+		{"Underflow", "0.00000000000000001", "3", "0"},
+		{"Underflow Negative", "-0.00000000000000001", "3", "-0.0000000000000001"},
+		{"Large Dividend", "123456789012345678901234567890", "7", "17636684144620811271604938270"},
+		{"Large Dividend Negative", "-123456789012345678901234567890", "7", "-17636684144620811271604938270"},
+		{"Max Divisor", "1", "9999999999999999999", "0"},
+		{"Divisor Fraction", "1", "0.0000000000000000003", "3333333333333333333.3333333333333333"},
+		{"Big Divisor", "100000000000000000000000000", "33333333333333333333333333", "3"},
+		{"Big Divisor Negative", "-1", "33333333333333333333333333", "-0.0000000000000001"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			su.Equal(tc.expected, tc.d1.Div(tc.d2).String(), "%s / %s", tc.d1, tc.d2)
+
+			// the last digit of the long division agrees with big.Int
+			a, b := normalize([]byte(tc.d1)), normalize([]byte(tc.d2))
+			if result, ok := divLong(a, b, DivisionPrecision); ok {
+				su.Equal(string(divBig(a, b, DivisionPrecision)), string(result), "%s / %s", tc.d1, tc.d2)
+			}
+		})
+	}
+}
+
+func TestDivAllocs(t *testing.T) {
+	a, b := normalize([]byte("12345.6789")), normalize([]byte("-3.21"))
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = divLong(a, b, DivisionPrecision)
+	})
+
+	if allocs > 1 {
+		t.Fatalf("divLong allocates %v times, want 1", allocs)
+	}
+}
+
+func TestPow10(t *testing.T) {
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for n := g; n < 300; n += 3 {
+				expected := "1" + strings.Repeat("0", n)
+				if got := pow10(n).String(); got != expected {
+					t.Errorf("pow10(%d) = %s", n, got)
+				}
+			}
+		}(g)
+	}
+
+	wg.Wait()
+
+	if pow10(pow10StaticSize+10) != pow10(pow10StaticSize+10) {
+		t.Fatal("pow10 doesn't return the cached value")
+	}
+}
@@ -0,0 +1,222 @@
+package decimal
+
+import (
+	"math"
+	"math/bits"
+)
+
+// maxFastDigits is the max count of the digits of the coefficient handled by the int64 fast path,
+// 10^18 - 1 always fits in an int64.
+const maxFastDigits = 18
+
+var pow10Int64 = [...]int64{
+	1, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9,
+	1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18,
+}
+
+// addFast returns a + b (a - b when negB is true) in machine integers.
+// ok is false when the operands are not tidy or the result overflows, the caller should fall back to the byte-string algorithms.
+func addFast(a, b Decimal, negB bool) (result Decimal, ok bool) {
+	x, xScale, ok := parseFast(a)
+	if !ok {
+		return "", false
+	}
+
+	y, yScale, ok := parseFast(b)
+	if !ok {
+		return "", false
+	}
+
+	if negB {
+		y = -y
+	}
+
+	// align the scales
+	scale := max(xScale, yScale)
+	if x, ok = mulPow10Int64(x, scale-xScale); !ok {
+		return "", false
+	}
+
+	if y, ok = mulPow10Int64(y, scale-yScale); !ok {
+		return "", false
+	}
+
+	sum := x + y
+	if (x >= 0) == (y >= 0) && (sum >= 0) != (x >= 0) {
+		return "", false
+	}
+
+	return formatFast(sum, scale), true
+}
+
+// mulFast returns a * b in machine integers.
+// ok is false when the operands are not tidy or the result overflows, the caller should fall back to the byte-string algorithms.
+func mulFast(a, b Decimal) (result Decimal, ok bool) {
+	x, xScale, ok := parseFast(a)
+	if !ok {
+		return "", false
+	}
+
+	y, yScale, ok := parseFast(b)
+	if !ok {
+		return "", false
+	}
+
+	neg := (x < 0) != (y < 0)
+	hi, lo := bits.Mul64(absInt64(x), absInt64(y))
+	if hi != 0 || lo > math.MaxInt64 {
+		return "", false
+	}
+
+	product := int64(lo)
+	if neg {
+		product = -product
+	}
+
+	return formatFast(product, xScale+yScale), true
+}
+
+// cmpFast compares a and b in machine integers.
+// ok is false when the operands are not tidy or the aligned coefficients overflow, the caller should fall back to the byte-string algorithms.
+func cmpFast(a, b Decimal) (result int, ok bool) {
+	x, xScale, ok := parseFast(a)
+	if !ok {
+		return 0, false
+	}
+
+	y, yScale, ok := parseFast(b)
+	if !ok {
+		return 0, false
+	}
+
+	// align the scales
+	scale := max(xScale, yScale)
+	if x, ok = mulPow10Int64(x, scale-xScale); !ok {
+		return 0, false
+	}
+
+	if y, ok = mulPow10Int64(y, scale-yScale); !ok {
+		return 0, false
+	}
+
+	switch {
+	case x < y:
+		return -1, true
+	case x > y:
+		return 1, true
+	default:
+		return 0, true
+	}
+}
+
+// parseFast parses a tidy decimal into its coefficient and the count of the digit right the decimal point.
+// ok is false when the decimal is not tidy or the coefficient has more than maxFastDigits digits.
+//
+// NOTE: NO COPY
+func parseFast(d Decimal) (coef int64, scale int, ok bool) {
+	if len(d) > maxFastDigits+2 || !isTidy(d, false) {
+		return 0, 0, false
+	}
+
+	neg := d[0] == '-'
+	i, digits := 0, 0
+	if neg {
+		i++
+	}
+
+	scale = -1
+	for ; i < len(d); i++ {
+		c := d[i]
+		if c == '.' {
+			scale = 0
+			continue
+		}
+
+		if scale >= 0 {
+			scale++
+		}
+
+		if digits == 0 && c == '0' {
+			continue
+		}
+
+		digits++
+		if digits > maxFastDigits {
+			return 0, 0, false
+		}
+
+		coef = coef*10 + int64(c-'0')
+	}
+
+	if neg {
+		coef = -coef
+	}
+
+	return coef, max(scale, 0), true
+}
+
+// formatFast formats coef * 10^-scale as a tidy decimal.
+func formatFast(coef int64, scale int) Decimal {
+	var buf [64]byte
+	u := absInt64(coef)
+
+	i := len(buf)
+	for digits := 0; u != 0 || digits <= scale; digits++ {
+		if digits == scale && scale != 0 {
+			i--
+			buf[i] = '.'
+		}
+
+		i--
+		buf[i] = byte(u%10) + '0'
+		u /= 10
+	}
+
+	if coef < 0 {
+		i--
+		buf[i] = '-'
+	}
+
+	result := buf[i:]
+	if scale != 0 {
+		end := len(result)
+		for result[end-1] == '0' {
+			end--
+		}
+
+		if result[end-1] == '.' {
+			end--
+		}
+
+		result = result[:end]
+	}
+
+	return Decimal(result)
+}
+
+// mulPow10Int64 returns x * 10^n, ok is false when it overflows.
+func mulPow10Int64(x int64, n int) (int64, bool) {
+	if n == 0 || x == 0 {
+		return x, true
+	}
+
+	if n >= len(pow10Int64) {
+		return 0, false
+	}
+
+	p := pow10Int64[n]
+	if x > math.MaxInt64/p || x < math.MinInt64/p {
+		return 0, false
+	}
+
+	return x * p, true
+}
+
+// absInt64 returns |x| as uint64, which is correct for math.MinInt64.
+func absInt64(x int64) uint64 {
+	if x < 0 {
+		return uint64(-x)
+	}
+
+	return uint64(x)
+}
@@ -0,0 +1,129 @@
+package decimal
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func randomDecimal(r *rand.Rand, maxDigits int) Decimal {
+	digits := r.Intn(maxDigits) + 1
+	builder := strings.Builder{}
+	if r.Intn(2) == 0 {
+		builder.WriteByte('-')
+	}
+
+	dot := r.Intn(digits + 1)
+	for i := 0; i < digits; i++ {
+		if i == dot && i != 0 {
+			builder.WriteByte('.')
+		}
+		builder.WriteByte(byte(r.Intn(10)) + '0')
+	}
+
+	return Require(builder.String())
+}
+
+func (su *DecimalSuite) TestFastPath() {
+	slowSub := func(a, b Decimal) Decimal { return Decimal(sub(normalize([]byte(a)), normalize([]byte(b)))) }
+	slowAdd := func(a, b Decimal) Decimal { return slowSub(a, b.Neg()) }
+	slowMul := func(a, b Decimal) Decimal { return Decimal(mul(normalize([]byte(a)), normalize([]byte(b)))) }
+
+	r := rand.New(rand.NewSource(31))
+	for i := 0; i < 5000; i++ {
+		a, b := randomDecimal(r, 20), randomDecimal(r, 20)
+
+		if result, ok := addFast(a, b, false); ok {
+			su.Equal(slowAdd(a, b).Canonical(), result, "%s + %s", a, b)
+			su.True(result.IsCanonical(), "%s + %s", a, b)
+		}
+
+		if result, ok := addFast(a, b, true); ok {
+			su.Equal(slowSub(a, b).Canonical(), result, "%s - %s", a, b)
+		}
+
+		if result, ok := mulFast(a, b); ok {
+			su.Equal(slowMul(a, b).Canonical(), result, "%s * %s", a, b)
+			su.True(result.IsCanonical(), "%s * %s", a, b)
+		}
+
+		if result, ok := cmpFast(a, b); ok {
+			su.Equal(compareCanonical(a.Canonical(), b.Canonical()), result, "%s cmp %s", a, b)
+		}
+
+		if result, ok := cmpFast(a, a.Shift(-1).Shift(1)); ok {
+			su.Equal(0, result, "%s cmp %s", a, a)
+		}
+	}
+}
+
+func (su *DecimalSuite) TestFastPathFallback() {
+	testCases := []struct {
+		desc     string
+		a, b     Decimal
+		add, mul bool
+	}{
+		{"Small", "1.5", "-2.25", true, true},
+		{"Not Tidy", "1,000", "1", false, false},
+		{"Too Many Digits", "1234567890123456789", "1", false, false},
+		{"Add Overflow", "999999999999999999", "999999999999999999", true, false},
+		{"Align Overflow", "999999999999999999", "0.1", false, true},
+		{"Mul Overflow", "999999999999", "999999999", true, false},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			_, ok := addFast(tc.a, tc.b, false)
+			su.Equal(tc.add, ok, tc.desc)
+			_, ok = mulFast(tc.a, tc.b)
+			su.Equal(tc.mul, ok, tc.desc)
+		})
+	}
+
+	_, ok := cmpFast("999999999999999999", "0.1")
+	su.False(ok)
+	su.Equal(1, Decimal("999999999999999999").Cmp("0.1"))
+	su.Equal(-1, Decimal("-1,000").Cmp("1"))
+
+	su.Equal("1999999999999999998", Require("999999999999999999").Add(Require("999999999999999999")).String())
+	su.Equal("999999999998999000000000001", Require("999999999999").Mul(Require("999999999999999")).String())
+}
+
+func (su *DecimalSuite) TestFormatFast() {
+	testCases := []struct {
+		coef     int64
+		scale    int
+		expected string
+	}{
+		{0, 0, "0"},
+		{0, 3, "0"},
+		{5, 3, "0.005"},
+		{-5, 3, "-0.005"},
+		{1500, 2, "15"},
+		{-1234, 1, "-123.4"},
+		{-9223372036854775808, 0, "-9223372036854775808"},
+		{9223372036854775807, 19, "0.9223372036854775807"},
+	}
+
+	for _, tc := range testCases {
+		su.Equal(tc.expected, string(formatFast(tc.coef, tc.scale)), "%d, %d", tc.coef, tc.scale)
+	}
+}
+
+func (su *DecimalSuite) TestNewFromIntFast() {
+	for _, v := range []int64{0, 7, -7, 123456789, math.MaxInt64, math.MinInt64} {
+		su.Equal(strconv.FormatInt(v, 10), string(NewFromInt(v)), v)
+	}
+
+	su.Equal("-2147483648", string(NewFromInt32(math.MinInt32)))
+
+	// the string is on the stack when the result doesn't escape
+	allocs := testing.AllocsPerRun(100, func() {
+		if NewFromInt(123456789).Cmp("123456788") != 1 {
+			su.Fail("NewFromInt(123456789) should be greater than 123456788")
+		}
+	})
+	su.Zero(allocs)
+}
@@ -0,0 +1,65 @@
+package decimal
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// NewFromFloatWithExponent converts a float64 to Decimal rounded to 10^exp, which is half away from zero
+// on the exact binary value of the float.
+//
+// NOTE: this will create zero value on NaN, +/-inf
+//
+// Example:
+//
+//	NewFromFloatWithExponent(123.456, -2).String() // "123.46"
+//	NewFromFloatWithExponent(123.456, 1).String()  // "120"
+//	NewFromFloatWithExponent(2.675, -2).String()   // "2.67", 2.675 is 2.67499999999999982236431605997495353221893310546875
+func NewFromFloatWithExponent(value float64, exp int) Decimal {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Zero
+	}
+
+	return NewFromRat(new(big.Rat).SetFloat64(value), -exp, RoundingHalfUp)
+}
+
+// NewFromFloatExact converts a float64 to Decimal with the full binary expansion of the float,
+// which is exact but may have many digits, unlike NewFromFloat which returns the shortest round-trip digits.
+//
+// NOTE: this will create zero value on NaN, +/-inf
+//
+// Example:
+//
+//	NewFromFloat(0.1).String()      // "0.1"
+//	NewFromFloatExact(0.1).String() // "0.1000000000000000055511151231257827021181583404541015625"
+func NewFromFloatExact(value float64) Decimal {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Zero
+	}
+
+	// the denominator of a float is a power of 2, so the expansion always terminates
+	d, _ := NewFromRatExact(new(big.Rat).SetFloat64(value))
+	return d
+}
+
+// Float32 returns the nearest float32 value for d and a bool indicating
+// whether f represents d exactly.
+// For more details, see the documentation for big.Rat.Float32
+func (d Decimal) Float32() (f float32, exact bool) {
+	return d.Rat().Float32()
+}
+
+// InexactFloat64 returns the nearest float64 value for d.
+// It doesn't indicate if the returned value represents d exactly, and it's faster than Float64.
+func (d Decimal) InexactFloat64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// InexactFloat32 returns the nearest float32 value for d.
+// It doesn't indicate if the returned value represents d exactly, and it's faster than Float32.
+func (d Decimal) InexactFloat32() float32 {
+	f, _ := strconv.ParseFloat(d.String(), 32)
+	return float32(f)
+}
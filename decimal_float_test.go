@@ -0,0 +1,110 @@
+package decimal
+
+import (
+	"math"
+	"math/rand"
+)
+
+func (su *DecimalSuite) TestNewFromFloat32RoundTrip() {
+	testCases := []struct {
+		input    float32
+		expected string
+	}{
+		{0.1, "0.1"},
+		{1.1, "1.1"},
+		{-3.14159, "-3.14159"},
+		{16777216, "16777216"},
+		{math.MaxFloat32, "340282350000000000000000000000000000000"},
+		{math.SmallestNonzeroFloat32, "0.000000000000000000000000000000000000000000001"},
+	}
+
+	for _, tc := range testCases {
+		d := NewFromFloat32(tc.input)
+		su.Equal(tc.expected, d.String(), tc.input)
+		su.Equal(tc.input, d.InexactFloat32(), tc.input)
+	}
+
+	r := rand.New(rand.NewSource(40))
+	for i := 0; i < 1000; i++ {
+		f := math.Float32frombits(r.Uint32())
+		if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+			continue
+		}
+
+		f2, _ := NewFromFloat32(f).Float32()
+		su.Require().Equal(f, f2, "%v", f)
+	}
+}
+
+func (su *DecimalSuite) TestInexactFloat64() {
+	r := rand.New(rand.NewSource(40))
+	for i := 0; i < 1000; i++ {
+		f := math.Float64frombits(r.Uint64())
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			continue
+		}
+
+		d := NewFromFloat(f)
+		su.Require().Equal(f, d.InexactFloat64(), "%v", f)
+
+		exact, _ := d.Float64()
+		su.Require().Equal(exact, d.InexactFloat64(), "%v", f)
+	}
+
+	f, exact := Require("0.5").Float32()
+	su.Equal(float32(0.5), f)
+	su.True(exact)
+
+	_, exact = Require("0.1").Float32()
+	su.False(exact)
+}
+
+func (su *DecimalSuite) TestNewFromFloatWithExponent() {
+	testCases := []struct {
+		value    float64
+		exp      int
+		expected string
+	}{
+		{123.456, -2, "123.46"},
+		{123.456, 0, "123"},
+		{123.456, 1, "120"},
+		{-123.456, -1, "-123.5"},
+		{2.675, -2, "2.67"},
+		{0.5, 0, "1"},
+		{-0.5, 0, "-1"},
+		{1e-10, -5, "0"},
+		{math.NaN(), -2, "0"},
+		{math.Inf(1), -2, "0"},
+	}
+
+	for _, tc := range testCases {
+		su.Equal(tc.expected, NewFromFloatWithExponent(tc.value, tc.exp).String(), "%v %d", tc.value, tc.exp)
+	}
+}
+
+func (su *DecimalSuite) TestNewFromFloatExact() {
+	testCases := []struct {
+		value    float64
+		expected string
+	}{
+		{0.1, "0.1000000000000000055511151231257827021181583404541015625"},
+		{-2.5, "-2.5"},
+		{1e20, "100000000000000000000"},
+		{math.NaN(), "0"},
+	}
+
+	for _, tc := range testCases {
+		d := NewFromFloatExact(tc.value)
+		su.Equal(tc.expected, d.String(), "%v", tc.value)
+		if !math.IsNaN(tc.value) {
+			f, exact := d.Float64()
+			su.True(exact, "%v", tc.value)
+			su.Equal(tc.value, f, "%v", tc.value)
+		}
+	}
+
+	// the smallest subnormal float64 is 2^-1074
+	d := NewFromFloatExact(math.SmallestNonzeroFloat64)
+	su.Equal(int32(-1074), d.Exponent())
+	su.Equal("4940656458412465441765687928682213723650598026143247644255856825006755072702087518652998363616359923797965646954457177309266567103559397963987747960107818781263007131903114045278458171678489821036887186360569987307230500063874091535649843873124733972731696151400317153853980741262385655911710266585566867681870395603106249319452715914924553293054565444011274801297099995419319894090804165633245247571478690147267801593552386115501348035264934720193790268107107491703332226844753335720832431936092382893458368060106011506169809753078342277318329247904982524730776375927247874656084778203734469699533647017972677717585125660551199131504891101451037862738167250955837389733598993664809941164205702637090279242767544565229087538682506419718265533447265625", d.Coefficient().String())
+}
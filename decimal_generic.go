@@ -0,0 +1,104 @@
+package decimal
+
+import (
+	"math"
+	"strconv"
+	"unsafe"
+)
+
+// Signed is a constraint of the signed integer types, including the named types like `type UserID int64`.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a constraint of the unsigned integer types, including the named types like `type Quantity uint32`.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is a constraint of the integer types.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float is a constraint of the floating-point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// NewFromUint64 converts an uint64 to Decimal.
+func NewFromUint64(value uint64) Decimal {
+	return Decimal(strconv.FormatUint(value, 10))
+}
+
+// NewFromInteger converts any integer type to Decimal.
+//
+// Example:
+//
+//	type Quantity uint32
+//	NewFromInteger(Quantity(12)).String() // "12"
+//	NewFromInteger(int8(-5)).String()     // "-5"
+func NewFromInteger[T Integer](value T) Decimal {
+	if value < 0 {
+		return NewFromInt(int64(value))
+	}
+
+	return NewFromUint64(uint64(value))
+}
+
+// NewFromFloating converts any floating-point type to Decimal, float32 values are converted
+// with the shortest digits of float32 like NewFromFloat32.
+//
+// NOTE: this will create zero value on NaN, +/-inf
+func NewFromFloating[T Float](value T) Decimal {
+	if unsafe.Sizeof(value) == 4 {
+		return NewFromFloat32(float32(value))
+	}
+
+	return NewFromFloat(float64(value))
+}
+
+// To converts the decimal to any integer or floating-point type.
+// ok reports whether the result represents d exactly: it's false when d is out of the range of T,
+// or when d has a non-zero fraction for the integer types, or when d isn't representable in binary for the floating-point types.
+// The fraction is truncated for the integer types, and the result is 0 when it overflows.
+//
+// Example:
+//
+//	type Quantity uint32
+//	To[Quantity](Require("12"))   // 12, true
+//	To[Quantity](Require("-1"))   // 0, false
+//	To[int8](Require("128"))      // 0, false
+//	To[float64](Require("0.5"))   // 0.5, true
+func To[T Integer | Float](d Decimal) (result T, ok bool) {
+	var (
+		one      T = 1
+		zero     T
+		bitSize  = int(unsafe.Sizeof(zero)) * 8
+		isFloat  = one/2 != 0
+		isSigned = zero-one < 0
+	)
+
+	switch {
+	case isFloat && bitSize == 32:
+		f, exact := d.Float32()
+		return T(f), exact && !math.IsInf(float64(f), 0)
+	case isFloat:
+		f, exact := d.Float64()
+		return T(f), exact && !math.IsInf(f, 0)
+	case isSigned:
+		i, fractional, err := d.IntPartChecked()
+		if err != nil || bitSize < 64 && (i < -1<<(bitSize-1) || i > 1<<(bitSize-1)-1) {
+			return 0, false
+		}
+
+		return T(i), !fractional
+	default:
+		u, exact := d.Uint64()
+		if bitSize < 64 && u > 1<<bitSize-1 {
+			return 0, false
+		}
+
+		return T(u), exact
+	}
+}
@@ -0,0 +1,73 @@
+package decimal
+
+import "math"
+
+type testQuantity uint32
+
+type testUserID int64
+
+func (su *DecimalSuite) TestNewFromInteger() {
+	su.Equal("12", NewFromInteger(testQuantity(12)).String())
+	su.Equal("-5", NewFromInteger(int8(-5)).String())
+	su.Equal("-9223372036854775808", NewFromInteger(testUserID(math.MinInt64)).String())
+	su.Equal("18446744073709551615", NewFromInteger(uint64(math.MaxUint64)).String())
+	su.Equal("65535", NewFromInteger(uint16(math.MaxUint16)).String())
+	su.Equal("18446744073709551615", NewFromUint64(math.MaxUint64).String())
+
+	su.Equal("0.1", NewFromFloating(float32(0.1)).String())
+	su.Equal("0.1", NewFromFloating(0.1).String())
+	su.Equal("0", NewFromFloating(math.Inf(1)).String())
+}
+
+func (su *DecimalSuite) TestTo() {
+	q, ok := To[testQuantity](Require("12"))
+	su.Equal(testQuantity(12), q)
+	su.True(ok)
+
+	q, ok = To[testQuantity](Require("-1"))
+	su.Equal(testQuantity(0), q)
+	su.False(ok)
+
+	q, ok = To[testQuantity](Require("4294967296"))
+	su.Equal(testQuantity(0), q)
+	su.False(ok)
+
+	q, ok = To[testQuantity](Require("12.5"))
+	su.Equal(testQuantity(12), q)
+	su.False(ok)
+
+	i8, ok := To[int8](Require("-128"))
+	su.Equal(int8(-128), i8)
+	su.True(ok)
+
+	i8, ok = To[int8](Require("128"))
+	su.Equal(int8(0), i8)
+	su.False(ok)
+
+	id, ok := To[testUserID](Require("-9223372036854775808"))
+	su.Equal(testUserID(math.MinInt64), id)
+	su.True(ok)
+
+	_, ok = To[testUserID](Require("9223372036854775808"))
+	su.False(ok)
+
+	u64, ok := To[uint64](Require("18446744073709551615"))
+	su.Equal(uint64(math.MaxUint64), u64)
+	su.True(ok)
+
+	f64, ok := To[float64](Require("0.5"))
+	su.Equal(0.5, f64)
+	su.True(ok)
+
+	f64, ok = To[float64](Require("0.1"))
+	su.Equal(0.1, f64)
+	su.False(ok)
+
+	f32, ok := To[float32](Require("0.1"))
+	su.Equal(float32(0.1), f32)
+	su.False(ok)
+
+	f32, ok = To[float32](Require("1").Shift(40))
+	su.True(math.IsInf(float64(f32), 1))
+	su.False(ok)
+}
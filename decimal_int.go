@@ -0,0 +1,95 @@
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// ErrIntOverflow is returned by IntPartChecked when the integer part of the decimal is out of the range of int64.
+var ErrIntOverflow = errors.New("integer overflow")
+
+// Int64 returns the decimal as int64, ok reports whether i represents d exactly,
+// which is false when d has a non-zero fraction or is out of the range of int64.
+// The fraction is truncated, and i is 0 when it overflows.
+//
+// Example:
+//
+//	Require("-12").Int64()                 // -12, true
+//	Require("12.5").Int64()                // 12, false
+//	Require("9223372036854775808").Int64() // 0, false
+func (d Decimal) Int64() (i int64, ok bool) {
+	i, fractional, err := d.IntPartChecked()
+	if err != nil {
+		return 0, false
+	}
+
+	return i, !fractional
+}
+
+// Int32 returns the decimal as int32, ok reports whether i represents d exactly,
+// which is false when d has a non-zero fraction or is out of the range of int32.
+// The fraction is truncated, and i is 0 when it overflows.
+func (d Decimal) Int32() (i int32, ok bool) {
+	i64, fractional, err := d.IntPartChecked()
+	if err != nil || i64 < math.MinInt32 || i64 > math.MaxInt32 {
+		return 0, false
+	}
+
+	return int32(i64), !fractional
+}
+
+// Uint64 returns the decimal as uint64, ok reports whether u represents d exactly,
+// which is false when d has a non-zero fraction or is out of the range of uint64, including the negative numbers.
+// The fraction is truncated, and u is 0 when it overflows.
+//
+// Example:
+//
+//	Require("18446744073709551615").Uint64() // 18446744073709551615, true
+//	Require("-1").Uint64()                   // 0, false
+//	Require("-0.5").Uint64()                 // 0, false
+func (d Decimal) Uint64() (u uint64, ok bool) {
+	integer, fractional := integerAndFractional(normalize([]byte(d)))
+	if integer[0] == '-' {
+		return 0, false
+	}
+
+	u, err := strconv.ParseUint(string(integer), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return u, !fractional
+}
+
+// IntPartChecked returns the integer part of the decimal like IntPart, but it returns ErrIntOverflow
+// instead of panic when the integer part is out of the range of int64.
+// fractional reports whether a non-zero fraction is truncated.
+//
+// Example:
+//
+//	Require("-12.5").IntPartChecked()                 // -12, true, nil
+//	Require("9223372036854775808").IntPartChecked()   // 0, false, ErrIntOverflow
+func (d Decimal) IntPartChecked() (i int64, fractional bool, err error) {
+	integer, fractional := integerAndFractional(normalize([]byte(d)))
+	i, err = strconv.ParseInt(string(integer), 10, 64)
+	if err != nil {
+		return 0, fractional, fmt.Errorf("%w: %s", ErrIntOverflow, integer)
+	}
+
+	return i, fractional, nil
+}
+
+// integerAndFractional returns the integer part of the tidy buf, which is "0" or "-0" for
+// the numbers between -1 and 1, and whether buf has a non-zero fraction.
+//
+// NOTE: NO COPY
+func integerAndFractional(buf []byte) (integer []byte, fractional bool) {
+	dotIdx := findDotIndex(buf)
+	if dotIdx == -1 {
+		return buf, false
+	}
+
+	return buf[:dotIdx], !isZero(buf[dotIdx+1:])
+}
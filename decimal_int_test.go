@@ -0,0 +1,62 @@
+package decimal
+
+import (
+	"errors"
+	"testing"
+)
+
+func (su *DecimalSuite) TestIntConversions() {
+	testCases := []struct {
+		desc       string
+		input      Decimal
+		i64        int64
+		i64OK      bool
+		i32        int32
+		i32OK      bool
+		u64        uint64
+		u64OK      bool
+		fractional bool
+		overflow   bool
+	}{
+		{"Integer", "12", 12, true, 12, true, 12, true, false, false},
+		{"Negative", "-12", -12, true, -12, true, 0, false, false, false},
+		{"Fraction", "12.5", 12, false, 12, false, 12, false, true, false},
+		{"Negative Fraction", "-0.5", 0, false, 0, false, 0, false, true, false},
+		{"Zero Fraction", "7.000", 7, true, 7, true, 7, true, false, false},
+		{"Zero", "", 0, true, 0, true, 0, true, false, false},
+		{"Max Int32", "2147483647", 2147483647, true, 2147483647, true, 2147483647, true, false, false},
+		{"Over Int32", "2147483648", 2147483648, true, 0, false, 2147483648, true, false, false},
+		{"Min Int32", "-2147483648", -2147483648, true, -2147483648, true, 0, false, false, false},
+		{"Max Int64", "9223372036854775807", 9223372036854775807, true, 0, false, 9223372036854775807, true, false, false},
+		{"Over Int64", "9223372036854775808", 0, false, 0, false, 9223372036854775808, true, false, true},
+		{"Min Int64", "-9223372036854775808", -9223372036854775808, true, 0, false, 0, false, false, false},
+		{"Max Uint64", "18446744073709551615.1", 0, false, 0, false, 18446744073709551615, false, true, true},
+		{"Over Uint64", "18446744073709551616", 0, false, 0, false, 0, false, false, true},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			i64, ok := tc.input.Int64()
+			su.Equal(tc.i64, i64, "Int64")
+			su.Equal(tc.i64OK, ok, "Int64")
+
+			i32, ok := tc.input.Int32()
+			su.Equal(tc.i32, i32, "Int32")
+			su.Equal(tc.i32OK, ok, "Int32")
+
+			u64, ok := tc.input.Uint64()
+			su.Equal(tc.u64, u64, "Uint64")
+			su.Equal(tc.u64OK, ok, "Uint64")
+
+			i, fractional, err := tc.input.IntPartChecked()
+			su.Equal(tc.fractional, fractional, "IntPartChecked")
+			if tc.overflow {
+				su.True(errors.Is(err, ErrIntOverflow), "IntPartChecked")
+				return
+			}
+
+			su.NoError(err, "IntPartChecked")
+			su.Equal(tc.input.IntPart(), i, "IntPartChecked")
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package decimal
+
+// DecimalMap is a map keyed by the value of the decimal, so "1.0" and "1" are the same key.
+//
+// The zero value is an empty map ready to use. It's not safe for concurrent use.
+//
+// Example:
+//
+//	m := decimal.DecimalMap[string]{}
+//	m.Set(decimal.Decimal("1.0"), "one")
+//	m.Get(decimal.Require("1")) // "one", true
+type DecimalMap[V any] struct {
+	m map[Decimal]V
+}
+
+// NewDecimalMap returns an empty DecimalMap with the given initial capacity.
+func NewDecimalMap[V any](capacity int) *DecimalMap[V] {
+	return &DecimalMap[V]{m: make(map[Decimal]V, capacity)}
+}
+
+// Get returns the value stored for the key and whether the key exists.
+func (m *DecimalMap[V]) Get(key Decimal) (V, bool) {
+	v, ok := m.m[key.Canonical()]
+	return v, ok
+}
+
+// Set stores the value for the key.
+func (m *DecimalMap[V]) Set(key Decimal, value V) {
+	if m.m == nil {
+		m.m = make(map[Decimal]V)
+	}
+
+	m.m[key.Canonical()] = value
+}
+
+// Delete removes the key from the map.
+func (m *DecimalMap[V]) Delete(key Decimal) {
+	delete(m.m, key.Canonical())
+}
+
+// Len returns the count of the keys in the map.
+func (m *DecimalMap[V]) Len() int {
+	return len(m.m)
+}
+
+// Range calls f sequentially for each key and value in the map in an unspecified order,
+// the keys are in the canonical form. If f returns false, Range stops the iteration.
+func (m *DecimalMap[V]) Range(f func(key Decimal, value V) bool) {
+	for k, v := range m.m {
+		if !f(k, v) {
+			return
+		}
+	}
+}
+
+// DecimalSet is a set of decimal values, so "1.0" and "1" are the same element.
+//
+// The zero value is an empty set ready to use. It's not safe for concurrent use.
+type DecimalSet struct {
+	m map[Decimal]struct{}
+}
+
+// NewDecimalSet returns a DecimalSet containing the given values.
+func NewDecimalSet(values ...Decimal) *DecimalSet {
+	s := &DecimalSet{m: make(map[Decimal]struct{}, len(values))}
+	for _, v := range values {
+		s.Add(v)
+	}
+
+	return s
+}
+
+// Add adds the value to the set.
+func (s *DecimalSet) Add(value Decimal) {
+	if s.m == nil {
+		s.m = make(map[Decimal]struct{})
+	}
+
+	s.m[value.Canonical()] = struct{}{}
+}
+
+// Has reports whether the value is in the set.
+func (s *DecimalSet) Has(value Decimal) bool {
+	_, ok := s.m[value.Canonical()]
+	return ok
+}
+
+// Remove removes the value from the set.
+func (s *DecimalSet) Remove(value Decimal) {
+	delete(s.m, value.Canonical())
+}
+
+// Len returns the count of the values in the set.
+func (s *DecimalSet) Len() int {
+	return len(s.m)
+}
+
+// Range calls f sequentially for each value in the set in an unspecified order,
+// the values are in the canonical form. If f returns false, Range stops the iteration.
+func (s *DecimalSet) Range(f func(value Decimal) bool) {
+	for v := range s.m {
+		if !f(v) {
+			return
+		}
+	}
+}
+
+// Values returns the values of the set in an unspecified order, the values are in the canonical form.
+func (s *DecimalSet) Values() []Decimal {
+	values := make([]Decimal, 0, len(s.m))
+	for v := range s.m {
+		values = append(values, v)
+	}
+
+	return values
+}
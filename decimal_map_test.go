@@ -0,0 +1,63 @@
+package decimal
+
+import (
+	"sort"
+)
+
+func (su *DecimalSuite) TestDecimalMap() {
+	m := DecimalMap[string]{}
+	_, ok := m.Get(Decimal("1"))
+	su.False(ok)
+
+	m.Set(Decimal("1.0"), "one")
+	m.Set(Decimal("2"), "two")
+	m.Set(Decimal("1"), "uno")
+	su.Equal(2, m.Len())
+
+	v, ok := m.Get(Decimal("1.00"))
+	su.True(ok)
+	su.Equal("uno", v)
+
+	keys := []string{}
+	m.Range(func(key Decimal, _ string) bool {
+		keys = append(keys, string(key))
+		return true
+	})
+	sort.Strings(keys)
+	su.Equal([]string{"1", "2"}, keys)
+
+	m.Delete(Decimal("+2.000"))
+	su.Equal(1, m.Len())
+
+	m2 := NewDecimalMap[int](4)
+	m2.Set(Decimal("-0.0"), 0)
+	v2, ok := m2.Get(Zero)
+	su.True(ok)
+	su.Equal(0, v2)
+}
+
+func (su *DecimalSuite) TestDecimalSet() {
+	s := NewDecimalSet(Decimal("1.0"), Decimal("1"), Decimal("2.50"))
+	su.Equal(2, s.Len())
+	su.True(s.Has(Decimal("2.5")))
+	su.False(s.Has(Decimal("3")))
+
+	values := s.Values()
+	sort.Slice(values, func(i, j int) bool { return values[i].LessThan(values[j]) })
+	su.Equal([]Decimal{"1", "2.5"}, values)
+
+	count := 0
+	s.Range(func(Decimal) bool {
+		count++
+		return false
+	})
+	su.Equal(1, count)
+
+	s.Remove(Decimal("1.000"))
+	su.Equal(1, s.Len())
+
+	var empty DecimalSet
+	su.False(empty.Has(Zero))
+	empty.Add(Zero)
+	su.True(empty.Has(Decimal("0.0")))
+}
@@ -0,0 +1,289 @@
+package decimal
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+const (
+	// lnGuardDigits is the count of the extra digits of the intermediate results of Ln and Exp.
+	lnGuardDigits = 10
+
+	// expHalvings is the count of the halvings of the reduced argument of Exp before the Taylor series.
+	expHalvings = 8
+
+	// maxExactPowBits is the max bit length of the exact integer power of PowWithPrecision,
+	// the larger powers are computed by squaring and multiplying with the rounded intermediates.
+	maxExactPowBits = 1 << 18
+)
+
+// DivRound returns d / d2 rounded half away from zero to precision decimal places, which is exact before rounding.
+// If precision < 0, it rounds the integer part to the nearest 10^(-precision).
+//
+// Example:
+//
+//	Require("2").DivRound(Require("3"), 4).String()  // "0.6667"
+//	Require("-1").DivRound(Require("8"), 2).String() // "-0.13"
+func (d Decimal) DivRound(d2 Decimal, precision int) Decimal {
+	if d2.IsZero() {
+		panic("division by zero")
+	}
+
+	return NewFromRat(new(big.Rat).Quo(d.Rat(), d2.Rat()), precision, RoundingHalfUp)
+}
+
+// Ln returns the natural logarithm of the decimal rounded half away from zero to precision decimal places.
+// It returns an error when the decimal is not positive.
+//
+// Example:
+//
+//	ln, err := Require("2").Ln(10) // "0.6931471806"
+//	ln, err = Require("0.5").Ln(5) // "-0.69315"
+func (d Decimal) Ln(precision int) (Decimal, error) {
+	if d.Sign() <= 0 {
+		return Zero, errors.New("can't calculate natural logarithm for non-positive decimals")
+	}
+
+	wp := max(precision, 0) + lnGuardDigits
+	one := pow10(wp)
+
+	// d = n / den, reduce it to y = d / 2^k in [2/3, 4/3) so that the series converges fast
+	r := d.Rat()
+	n, den := new(big.Int).Set(r.Num()), new(big.Int).Set(r.Denom())
+	k := n.BitLen() - den.BitLen()
+	if k > 0 {
+		den.Lsh(den, uint(k))
+	} else if k < 0 {
+		n.Lsh(n, uint(-k))
+	}
+
+	// now n / den is in (1/2, 2), move it into [2/3, 4/3)
+	if t := new(big.Int).Mul(n, big.NewInt(3)); t.Cmp(new(big.Int).Lsh(den, 2)) >= 0 {
+		den.Lsh(den, 1)
+		k++
+	} else if t.Cmp(new(big.Int).Lsh(den, 1)) < 0 {
+		n.Lsh(n, 1)
+		k--
+	}
+
+	// ln(y) = 2 * atanh(z), where z = (y - 1) / (y + 1) = (n - den) / (n + den)
+	z := new(big.Int).Mul(new(big.Int).Sub(n, den), one)
+	z.Quo(z, new(big.Int).Add(n, den))
+	result := atanhFixed(z, one)
+
+	if k != 0 {
+		// ln(2) = 2 * atanh(1/3)
+		ln2 := atanhFixed(new(big.Int).Quo(one, big.NewInt(3)), one)
+		result.Add(result, ln2.Mul(ln2, big.NewInt(int64(k))))
+	}
+
+	return NewFromRat(new(big.Rat).SetFrac(result, one), precision, RoundingHalfUp), nil
+}
+
+// Exp returns e to the power of the decimal rounded half away from zero to precision decimal places.
+//
+// Example:
+//
+//	Require("1").Exp(20).String() // "2.71828182845904523536"
+//	Require("-2").Exp(5).String() // "0.13534"
+//
+// It panics when the result is too large to be represented, e.g. e^(10^10).
+func (d Decimal) Exp(precision int) Decimal {
+	x := d.Rat()
+	if x.Sign() == 0 {
+		return NewFromRat(x.SetInt64(1), precision, RoundingHalfUp)
+	}
+
+	// e^x = 2^k * e^r, where k = round(x / ln(2)) and |r| <= ln(2) / 2
+	f, _ := x.Float64()
+	if f > math.MaxInt32 {
+		panic("exp overflow")
+	}
+
+	k := int64(math.Round(f / math.Ln2))
+	if float64(-k)*math.Log10(2) > float64(precision+1) {
+		// the result is less than half of the last digit
+		return Zero
+	}
+
+	// the digits of the integer part of 2^k are needed besides precision
+	digits := max(0, max(precision, 0)+int(math.Ceil(float64(k)*math.Log10(2))))
+	wp := digits + lnGuardDigits + len(big.NewInt(k).String())
+	one := pow10(wp)
+
+	ln2 := atanhFixed(new(big.Int).Quo(one, big.NewInt(3)), one)
+	r := new(big.Int).Mul(x.Num(), one)
+	r.Quo(r, x.Denom())
+	r.Sub(r, ln2.Mul(ln2, big.NewInt(k)))
+
+	// e^r = (e^(r / 2^n))^(2^n), the Taylor series of the small argument converges fast
+	r.Quo(r, big.NewInt(1<<expHalvings))
+	sum, term := new(big.Int).Set(one), new(big.Int).Set(one)
+	for i := int64(1); ; i++ {
+		term.Mul(term, r)
+		term.Quo(term, one)
+		term.Quo(term, big.NewInt(i))
+		if term.Sign() == 0 {
+			break
+		}
+
+		sum.Add(sum, term)
+	}
+
+	for range expHalvings {
+		sum.Mul(sum, sum)
+		sum.Quo(sum, one)
+	}
+
+	den := new(big.Int).Set(one)
+	if k >= 0 {
+		sum.Lsh(sum, uint(k))
+	} else {
+		den.Lsh(den, uint(-k))
+	}
+
+	return NewFromRat(new(big.Rat).SetFrac(sum, den), precision, RoundingHalfUp)
+}
+
+// PowWithPrecision returns d to the power d2 rounded half away from zero to precision decimal places,
+// d2 can have a fraction unlike Pow.
+// It returns an error when d is 0 and d2 is negative, or d is negative and d2 is not an integer.
+//
+// Example:
+//
+//	Require("2").PowWithPrecision(Require("0.5"), 10)   // "1.4142135624"
+//	Require("1.05").PowWithPrecision(Require("2.5"), 8) // "1.12972632"
+//	Require("2").PowWithPrecision(Require("-2"), 2)     // "0.25"
+func (d Decimal) PowWithPrecision(d2 Decimal, precision int) (Decimal, error) {
+	// -math.MinInt64 overflows, which falls through to e^(d2 * ln(d))
+	if k, ok := d2.Int64(); ok && k != math.MinInt64 {
+		if d.IsZero() && k < 0 {
+			return Zero, errors.New("can't raise 0 to a negative power")
+		}
+
+		if k > 0 {
+			// the positive power is a decimal, rounding its digits saves the gcd and the division of big.Rat
+			c, scale := bigIntWithScale(normalize([]byte(d)))
+			if exactPowFits(c, pow10(scale), k) {
+				c.Exp(c, big.NewInt(k), nil)
+				return Decimal(tidyBytes(shift([]byte(c.String()), -scale*int(k)))).Round(precision), nil
+			}
+		}
+
+		r := d.Rat()
+		if k < 0 {
+			r.Inv(r)
+			k = -k
+		}
+
+		// the integer power is exact before rounding when it's small enough
+		if p, ok := ratPow(r, k); ok {
+			return NewFromRat(p, precision, RoundingHalfUp), nil
+		}
+
+		return NewFromRat(powRounded(r, k, precision), precision, RoundingHalfUp), nil
+	}
+
+	switch d.Sign() {
+	case 0:
+		if d2.Sign() < 0 {
+			return Zero, errors.New("can't raise 0 to a negative power")
+		}
+
+		return Zero, nil
+	case -1:
+		return Zero, errors.New("can't raise a negative decimal to a non-integer power")
+	}
+
+	// d^d2 = e^(d2 * ln(d)), the error of ln(d) is multiplied by d2 and the result
+	estimate, _ := d.Ln(lnGuardDigits)
+	f, _ := estimate.Rat().Float64()
+	f *= d2.InexactFloat64()
+	resultDigits := max(0, int(math.Ceil(f/math.Ln10)))
+	exponentDigits := len(d2.Abs().Truncate(0).String())
+
+	ln, _ := d.Ln(max(precision, 0) + lnGuardDigits + resultDigits + exponentDigits)
+	return ln.Mul(d2).Exp(precision), nil
+}
+
+// ratPow returns r^k exactly for k >= 0, it returns false when the numerator and the denominator of the power
+// would have more than maxExactPowBits bits in total.
+func ratPow(r *big.Rat, k int64) (*big.Rat, bool) {
+	num, den := new(big.Int).Set(r.Num()), new(big.Int).Set(r.Denom())
+	if !exactPowFits(num, den, k) {
+		return nil, false
+	}
+
+	e := big.NewInt(k)
+	return new(big.Rat).SetFrac(num.Exp(num, e, nil), den.Exp(den, e, nil)), true
+}
+
+// exactPowFits reports whether num^k and den^k for k > 0 have at most maxExactPowBits bits in total,
+// it's shared by Pow and PowWithPrecision to bound the size of the exact power.
+func exactPowFits(num, den *big.Int, k int64) bool {
+	return float64(k)*(log2Abs(num)+log2Abs(den)) <= maxExactPowBits
+}
+
+// log2Abs returns log2|x|, or 0 when x is 0.
+func log2Abs(x *big.Int) float64 {
+	if x.Sign() == 0 {
+		return 0
+	}
+
+	mant := new(big.Float).SetInt(x)
+	exp := mant.MantExp(mant)
+	m, _ := mant.Abs(mant).Float64()
+	return float64(exp) + math.Log2(m)
+}
+
+// powRounded returns r^k for k > 0 by squaring and multiplying, the intermediates are rounded to the bits
+// of the integer part of the power plus precision decimal places, and the guard bits of the error multiplied by k.
+func powRounded(r *big.Rat, k int64, precision int) *big.Rat {
+	intBits := max(0, int(math.Ceil(float64(k)*(log2Abs(r.Num())-log2Abs(r.Denom()))))+1)
+	prec := uint(float64(max(precision, 0)+lnGuardDigits)*math.Log2(10)) + uint(intBits) + uint(bits.Len64(uint64(k)))
+
+	base := new(big.Float).SetPrec(prec).SetRat(r)
+	result := new(big.Float).SetPrec(prec).SetInt64(1)
+	for {
+		if k&1 == 1 {
+			result.Mul(result, base)
+		}
+
+		if k >>= 1; k == 0 {
+			break
+		}
+
+		base.Mul(base, base)
+	}
+
+	if result.IsInf() {
+		panic("pow overflow")
+	}
+
+	p, _ := result.Rat(nil)
+	return p
+}
+
+// atanhFixed returns 2 * atanh(z) in the fixed-point of one, where z is also in the fixed-point of one and |z| < 1.
+//
+//	2 * atanh(z) = 2 * (z + z^3/3 + z^5/5 + ...)
+func atanhFixed(z, one *big.Int) *big.Int {
+	sum := new(big.Int).Set(z)
+	z2 := new(big.Int).Mul(z, z)
+	z2.Quo(z2, one)
+
+	term, t := new(big.Int).Set(z), new(big.Int)
+	for i := int64(3); ; i += 2 {
+		term.Mul(term, z2)
+		term.Quo(term, one)
+		if term.Sign() == 0 {
+			break
+		}
+
+		sum.Add(sum, t.Quo(term, big.NewInt(i)))
+	}
+
+	return sum.Lsh(sum, 1)
+}
@@ -0,0 +1,148 @@
+package decimal
+
+import (
+	"testing"
+)
+
+func (su *DecimalSuite) TestDivRound() {
+	testCases := []struct {
+		d, d2     string
+		precision int
+		expected  string
+	}{
+		{"2", "3", 4, "0.6667"},
+		{"-2", "3", 4, "-0.6667"},
+		{"-1", "8", 2, "-0.13"},
+		{"1", "8", 2, "0.13"},
+		{"10", "4", 0, "3"},
+		{"1250", "1", -2, "1300"},
+		{"1", "3", 30, "0.333333333333333333333333333333"},
+	}
+
+	for _, tc := range testCases {
+		result := Require(tc.d).DivRound(Require(tc.d2), tc.precision)
+		su.Equal(tc.expected, result.String(), "%s / %s", tc.d, tc.d2)
+	}
+
+	su.Panics(func() { Require("1").DivRound(Zero, 2) })
+}
+
+func (su *DecimalSuite) TestLn() {
+	testCases := []struct {
+		d         string
+		precision int
+		expected  string
+	}{
+		{"1", 10, "0"},
+		{"2", 10, "0.6931471806"},
+		{"0.5", 5, "-0.69315"},
+		{"3", 30, "1.098612288668109691395245236923"},
+		{"10", 30, "2.302585092994045684017991454684"},
+		{"0.001", 30, "-6.907755278982137052053974364053"},
+		{"1.0001", 30, "0.000099995000333308335333166681"},
+		{"123456789.123", 30, "18.631401767164318041763956576764"},
+		{"2.718281828459045235360287", 20, "1"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.d, func(t *testing.T) {
+			result, err := Require(tc.d).Ln(tc.precision)
+			su.Require().NoError(err)
+			su.Equal(tc.expected, result.String(), "ln(%s)", tc.d)
+		})
+	}
+
+	for _, d := range []string{"0", "-1"} {
+		_, err := Require(d).Ln(10)
+		su.Error(err, d)
+	}
+}
+
+func (su *DecimalSuite) TestExp() {
+	testCases := []struct {
+		d         string
+		precision int
+		expected  string
+	}{
+		{"0", 10, "1"},
+		{"1", 30, "2.718281828459045235360287471353"},
+		{"-2", 30, "0.135335283236612691893999494972"},
+		{"-2", 5, "0.13534"},
+		{"0.5", 30, "1.648721270700128146848650787814"},
+		{"10", 30, "22026.465794806716516957900645284244"},
+		{"-10", 30, "0.000045399929762484851535591516"},
+		{"0.0001", 30, "1.000100005000166670833416668056"},
+		{"100", 10, "26881171418161354484126255515800135873611118.7737419224"},
+		{"-0.3465735902799726547", 30, "0.707106781186547524406936837074"},
+		{"-100", 30, "0"},
+		{"-1000000", 10, "0"},
+		{"2", -1, "10"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.d, func(t *testing.T) {
+			su.Equal(tc.expected, Require(tc.d).Exp(tc.precision).String(), "exp(%s)", tc.d)
+		})
+	}
+
+	// exp(ln(x)) == x
+	for _, d := range []string{"0.001", "2", "12345.6789"} {
+		ln, err := Require(d).Ln(40)
+		su.Require().NoError(err)
+		su.Equal(d, ln.Exp(20).String())
+	}
+
+	su.Panics(func() { Require("10000000000").Exp(2) })
+}
+
+func (su *DecimalSuite) TestPowWithPrecision() {
+	testCases := []struct {
+		d, d2     string
+		precision int
+		expected  string
+	}{
+		{"2", "0.5", 30, "1.41421356237309504880168872421"},
+		{"1.05", "2.5", 30, "1.129726321947045721750119514527"},
+		{"10", "-0.5", 30, "0.316227766016837933199889354443"},
+		{"1000", "1.5", 30, "31622.776601683793319988935444327185"},
+		{"1.05", "360.25", 30, "42997676.703108143376005655329366077215"},
+		{"0.5", "100.5", 30, "0.000000000000000000000000000001"},
+		{"2", "-2", 2, "0.25"},
+		{"3", "-1", 4, "0.3333"},
+		{"-2", "3", 2, "-8"},
+		{"1.1", "0", 2, "1"},
+		{"0", "0.5", 2, "0"},
+		{"1.0000001", "100000", 10, "1.0100501666"},
+		{"1.0000001", "-100000", 10, "0.9900498342"},
+		{"-1.0000001", "100001", 10, "-1.0100502676"},
+		{"1.0001", "300000", 20, "10670457952892.91113092961380584427"},
+		{"0.99999", "-777777", 30, "2387.038819548674286010256239120604"},
+		{"0.5", "1000000", 10, "0"},
+		{"-1", "1000001", 2, "-1"},
+		{"1.5", "2", 1, "2.3"},
+		{"-1.5", "3", 2, "-3.38"},
+		{"15", "3", -2, "3400"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.d+"^"+tc.d2, func(t *testing.T) {
+			result, err := Require(tc.d).PowWithPrecision(Require(tc.d2), tc.precision)
+			su.Require().NoError(err)
+			su.Equal(tc.expected, result.String(), "%s^%s", tc.d, tc.d2)
+		})
+	}
+
+	large, err := Require("2").PowWithPrecision(Require("100000"), 0)
+	su.Require().NoError(err)
+	su.Len(large.String(), 30103)
+	su.Equal(Require("2").Pow(Require("100000")).String(), large.String())
+
+	_, err = Zero.PowWithPrecision(Require("-1"), 2)
+	su.Error(err)
+
+	_, err = Zero.PowWithPrecision(Require("-0.5"), 2)
+	su.Error(err)
+
+	_, err = Require("-2").PowWithPrecision(Require("0.5"), 2)
+	su.Error(err)
+}
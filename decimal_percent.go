@@ -0,0 +1,78 @@
+package decimal
+
+// NewFromPercent converts a percentage to the ratio, e.g. 12.5 -> 0.125.
+func NewFromPercent(percent Decimal) Decimal {
+	return percent.Shift(-2)
+}
+
+// NewFromBasisPoints converts basis points to the ratio, e.g. 25 -> 0.0025.
+func NewFromBasisPoints(bps Decimal) Decimal {
+	return bps.Shift(-4)
+}
+
+// Percent converts the ratio to a percentage, e.g. 0.125 -> 12.5.
+func (d Decimal) Percent() Decimal {
+	return d.Shift(2)
+}
+
+// BasisPoints converts the ratio to basis points, e.g. 0.0025 -> 25.
+func (d Decimal) BasisPoints() Decimal {
+	return d.Shift(4)
+}
+
+// PercentOf returns percent% of the decimal.
+//
+// Example:
+//
+//	Require("200").PercentOf(Require("12.5")).String() // "25"
+func (d Decimal) PercentOf(percent Decimal) Decimal {
+	return d.Mul(NewFromPercent(percent))
+}
+
+// BasisPointsOf returns bps basis points of the decimal.
+//
+// Example:
+//
+//	Require("10000").BasisPointsOf(Require("25")).String() // "25"
+func (d Decimal) BasisPointsOf(bps Decimal) Decimal {
+	return d.Mul(NewFromBasisPoints(bps))
+}
+
+// AddPercent returns the decimal increased by percent%, which is d * (1 + percent / 100).
+//
+// Example:
+//
+//	Require("200").AddPercent(Require("12.5")).String() // "225"
+func (d Decimal) AddPercent(percent Decimal) Decimal {
+	return d.Add(d.PercentOf(percent))
+}
+
+// SubPercent returns the decimal decreased by percent%, which is d * (1 - percent / 100).
+//
+// Example:
+//
+//	Require("200").SubPercent(Require("12.5")).String() // "175"
+func (d Decimal) SubPercent(percent Decimal) Decimal {
+	return d.Sub(d.PercentOf(percent))
+}
+
+// PercentChange returns the percentage change from `from` to `to`, which is (to - from) / |from| * 100,
+// with DivisionPrecision. It panics when from is 0.
+//
+// Example:
+//
+//	PercentChange(Require("80"), Require("100")).String()  // "25"
+//	PercentChange(Require("-50"), Require("-25")).String() // "50"
+func PercentChange(from, to Decimal) Decimal {
+	return to.Sub(from).Shift(2).Div(from.Abs())
+}
+
+// StringPercent formats the ratio as a percentage, e.g. 0.125 -> "12.5%".
+func (d Decimal) StringPercent() string {
+	return d.Percent().String() + "%"
+}
+
+// StringBasisPoints formats the ratio as basis points, e.g. 0.0025 -> "25 bps".
+func (d Decimal) StringBasisPoints() string {
+	return d.BasisPoints().String() + " bps"
+}
@@ -0,0 +1,45 @@
+package decimal
+
+import (
+	"testing"
+)
+
+func (su *DecimalSuite) TestPercent() {
+	testCases := []struct {
+		desc     string
+		calc     func() Decimal
+		expected string
+	}{
+		{"From Percent", func() Decimal { return NewFromPercent(Require("12.5")) }, "0.125"},
+		{"From Basis Points", func() Decimal { return NewFromBasisPoints(Require("25")) }, "0.0025"},
+		{"Percent", func() Decimal { return Require("0.125").Percent() }, "12.5"},
+		{"Basis Points", func() Decimal { return Require("0.0025").BasisPoints() }, "25"},
+		{"Percent Of", func() Decimal { return Require("200").PercentOf(Require("12.5")) }, "25"},
+		{"Percent Of Fraction", func() Decimal { return Require("0.01").PercentOf(Require("0.1")) }, "0.00001"},
+		{"Basis Points Of", func() Decimal { return Require("10000").BasisPointsOf(Require("25")) }, "25"},
+		{"Basis Points Of Fraction", func() Decimal { return Require("123.45").BasisPointsOf(Require("2.5")) }, "0.03086250"},
+		{"Add Percent", func() Decimal { return Require("200").AddPercent(Require("12.5")) }, "225"},
+		{"Sub Percent", func() Decimal { return Require("200").SubPercent(Require("12.5")) }, "175"},
+		{"Sub Percent Negative", func() Decimal { return Require("-200").SubPercent(Require("-10")) }, "-220"},
+		{"Change", func() Decimal { return PercentChange(Require("80"), Require("100")) }, "25"},
+		{"Change Down", func() Decimal { return PercentChange(Require("100"), Require("80")) }, "-20"},
+		{"Change Negative Base", func() Decimal { return PercentChange(Require("-50"), Require("-25")) }, "50"},
+		{"Change Repeating", func() Decimal { return PercentChange(Require("3"), Require("4")) }, "33.3333333333333333"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			su.True(Require(tc.expected).Equal(tc.calc()), "%s: expected %s, got %s", tc.desc, tc.expected, tc.calc())
+		})
+	}
+
+	su.Panics(func() { PercentChange(Zero, Require("1")) })
+}
+
+func (su *DecimalSuite) TestStringPercent() {
+	su.Equal("12.5%", Require("0.125").StringPercent())
+	su.Equal("-0.01%", Require("-0.0001").StringPercent())
+	su.Equal("0%", Zero.StringPercent())
+	su.Equal("25 bps", Require("0.0025").StringBasisPoints())
+	su.Equal("0.5 bps", Require("0.00005").StringBasisPoints())
+}
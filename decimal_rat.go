@@ -0,0 +1,169 @@
+package decimal
+
+import (
+	"math/big"
+	"strings"
+)
+
+var bigTen = big.NewInt(10)
+
+// NewFromRat returns the decimal of r rounded to precision decimal places with the given rounding mode.
+// If precision < 0, it rounds the integer part to the nearest 10^(-precision).
+//
+// Example:
+//
+//	NewFromRat(big.NewRat(2, 3), 4, RoundingHalfUp).String()  // "0.6667"
+//	NewFromRat(big.NewRat(-2, 3), 4, RoundingFloor).String()  // "-0.6667"
+//	NewFromRat(big.NewRat(1250, 1), -2, RoundingHalfEven)     // "1200"
+func NewFromRat(r *big.Rat, precision int, mode RoundingMode) Decimal {
+	num, den := new(big.Int).Set(r.Num()), new(big.Int).Set(r.Denom())
+	if precision >= 0 {
+		num.Mul(num, pow10(precision))
+	} else {
+		den.Mul(den, pow10(-precision))
+	}
+
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	q = roundQuotient(q, rem, den, mode)
+
+	return Decimal(tidyBytes(shift([]byte(q.String()), -precision)))
+}
+
+// NewFromRatExact returns the exact decimal of r. ok is false when the decimal expansion of r doesn't terminate,
+// which means the denominator has prime factors other than 2 and 5, e.g. 1/3.
+//
+// Example:
+//
+//	NewFromRatExact(big.NewRat(5, 4)) // "1.25", true
+//	NewFromRatExact(big.NewRat(1, 3)) // "0", false
+func NewFromRatExact(r *big.Rat) (d Decimal, ok bool) {
+	scale, m := splitDenominator(r.Denom())
+	if m.Cmp(bigOne) != 0 {
+		return Zero, false
+	}
+
+	// r = num / (2^a * 5^b), multiply both by 10^scale so that the denominator becomes 10^scale
+	num := new(big.Int).Mul(r.Num(), pow10(scale))
+	num.Quo(num, r.Denom())
+
+	return Decimal(tidyBytes(shift([]byte(num.String()), -scale))), true
+}
+
+// RatExpansion returns the exact decimal expansion of r. prefix is the non-repeating part
+// including its fraction digits, and repetend is the repeating digits right after prefix,
+// which is empty when the expansion terminates.
+// ok is false when the repetend has more than maxDigits digits.
+//
+// Example:
+//
+//	RatExpansion(big.NewRat(1, 3), 100)   // "0", "3", true
+//	RatExpansion(big.NewRat(1, 30), 100)  // "0.0", "3", true
+//	RatExpansion(big.NewRat(-22, 7), 100) // "-3", "142857", true
+//	RatExpansion(big.NewRat(5, 4), 100)   // "1.25", "", true
+func RatExpansion(r *big.Rat, maxDigits int) (prefix string, repetend string, ok bool) {
+	num, den := new(big.Int).Abs(r.Num()), r.Denom()
+	preDigits, m := splitDenominator(den)
+
+	q, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+
+	sb := strings.Builder{}
+	if r.Sign() < 0 {
+		sb.WriteByte('-')
+	}
+
+	sb.WriteString(q.String())
+	if preDigits != 0 {
+		sb.WriteByte('.')
+	}
+
+	// the digits before the repetend
+	digit := new(big.Int)
+	for i := 0; i < preDigits; i++ {
+		rem.Mul(rem, bigTen)
+		digit.QuoRem(rem, den, rem)
+		sb.WriteByte(byte(digit.Int64()) + '0')
+	}
+
+	prefix = sb.String()
+	if m.Cmp(bigOne) == 0 {
+		return prefix, "", true
+	}
+
+	// 10 and m are coprime, so the remainders cycle back to the remainder right after the prefix
+	sb.Reset()
+	start := new(big.Int).Set(rem)
+	for i := 0; i == 0 || rem.Cmp(start) != 0; i++ {
+		if i == maxDigits {
+			return "", "", false
+		}
+
+		rem.Mul(rem, bigTen)
+		digit.QuoRem(rem, den, rem)
+		sb.WriteByte(byte(digit.Int64()) + '0')
+	}
+
+	return prefix, sb.String(), true
+}
+
+// RecurringString returns the exact decimal expansion of r with the repetend in the parentheses.
+// ok is false when the repetend has more than maxDigits digits.
+//
+// Example:
+//
+//	RecurringString(big.NewRat(1, 3), 100)   // "0.(3)", true
+//	RecurringString(big.NewRat(1, 6), 100)   // "0.1(6)", true
+//	RecurringString(big.NewRat(-22, 7), 100) // "-3.(142857)", true
+//	RecurringString(big.NewRat(5, 4), 100)   // "1.25", true
+func RecurringString(r *big.Rat, maxDigits int) (s string, ok bool) {
+	prefix, repetend, ok := RatExpansion(r, maxDigits)
+	if !ok || len(repetend) == 0 {
+		return prefix, ok
+	}
+
+	if strings.IndexByte(prefix, '.') == -1 {
+		prefix += "."
+	}
+
+	return prefix + "(" + repetend + ")", true
+}
+
+// DivExact returns d / d2 and whether the result is exact.
+// The result has all the digits when the expansion terminates, otherwise it's the same as Div.
+//
+// Example:
+//
+//	Require("1").DivExact(Require("8")) // "0.125", true
+//	Require("1").DivExact(Require("3")) // "0.3333333333333333", false
+func (d Decimal) DivExact(d2 Decimal) (Decimal, bool) {
+	if d2.IsZero() {
+		panic("division by zero")
+	}
+
+	if q, ok := NewFromRatExact(new(big.Rat).Quo(d.Rat(), d2.Rat())); ok {
+		return q, true
+	}
+
+	return d.Div(d2), false
+}
+
+// splitDenominator returns the count of the digits before the repetend, which is max(a, b),
+// and m where den = 2^a * 5^b * m.
+func splitDenominator(den *big.Int) (preDigits int, m *big.Int) {
+	m = new(big.Int).Set(den)
+	twos := int(m.TrailingZeroBits())
+	m.Rsh(m, uint(twos))
+
+	fives := 0
+	five, q, rem := big.NewInt(5), new(big.Int), new(big.Int)
+	for {
+		q.QuoRem(m, five, rem)
+		if rem.Sign() != 0 {
+			break
+		}
+
+		m.Set(q)
+		fives++
+	}
+
+	return max(twos, fives), m
+}
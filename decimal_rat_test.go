@@ -0,0 +1,103 @@
+package decimal
+
+import (
+	"math/big"
+	"testing"
+)
+
+func (su *DecimalSuite) TestRat() {
+	testCases := []Decimal{"0", "1.5", "-0.001", "123456789012345678901234567890.0987654321", "+1,000.50"}
+	for _, d := range testCases {
+		expected, ok := new(big.Rat).SetString(d.String())
+		su.Require().True(ok)
+		su.Equal(expected.String(), d.Rat().String(), d)
+	}
+}
+
+func (su *DecimalSuite) TestNewFromRat() {
+	testCases := []struct {
+		desc      string
+		r         *big.Rat
+		precision int
+		mode      RoundingMode
+		expected  string
+	}{
+		{"Half Up", big.NewRat(2, 3), 4, RoundingHalfUp, "0.6667"},
+		{"Toward Zero", big.NewRat(2, 3), 4, RoundingTowardToZero, "0.6666"},
+		{"Floor Negative", big.NewRat(-2, 3), 4, RoundingFloor, "-0.6667"},
+		{"Ceil Negative", big.NewRat(-2, 3), 4, RoundingCeil, "-0.6666"},
+		{"Half Even", big.NewRat(5, 8), 2, RoundingHalfEven, "0.62"},
+		{"Exact", big.NewRat(5, 4), 4, RoundingHalfUp, "1.25"},
+		{"Negative Precision", big.NewRat(1250, 1), -2, RoundingHalfEven, "1200"},
+		{"Zero", big.NewRat(0, 1), 2, RoundingHalfUp, "0"},
+		{"Round To Zero", big.NewRat(-1, 300), 2, RoundingHalfUp, "0"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			su.Equal(tc.expected, NewFromRat(tc.r, tc.precision, tc.mode).String(), tc.desc)
+		})
+	}
+}
+
+func (su *DecimalSuite) TestRatExpansion() {
+	testCases := []struct {
+		r         *big.Rat
+		prefix    string
+		repetend  string
+		recurring string
+		exact     bool
+	}{
+		{big.NewRat(1, 3), "0", "3", "0.(3)", false},
+		{big.NewRat(1, 6), "0.1", "6", "0.1(6)", false},
+		{big.NewRat(1, 30), "0.0", "3", "0.0(3)", false},
+		{big.NewRat(-22, 7), "-3", "142857", "-3.(142857)", false},
+		{big.NewRat(1, 81), "0", "012345679", "0.(012345679)", false},
+		{big.NewRat(5, 4), "1.25", "", "1.25", true},
+		{big.NewRat(-7, 1), "-7", "", "-7", true},
+		{big.NewRat(0, 1), "0", "", "0", true},
+	}
+
+	for _, tc := range testCases {
+		prefix, repetend, ok := RatExpansion(tc.r, 100)
+		su.Require().True(ok, tc.r)
+		su.Equal(tc.prefix, prefix, tc.r)
+		su.Equal(tc.repetend, repetend, tc.r)
+
+		recurring, ok := RecurringString(tc.r, 100)
+		su.Require().True(ok, tc.r)
+		su.Equal(tc.recurring, recurring, tc.r)
+
+		d, exact := NewFromRatExact(tc.r)
+		su.Equal(tc.exact, exact, tc.r)
+		if exact {
+			su.Equal(tc.recurring, d.String(), tc.r)
+		}
+	}
+
+	_, _, ok := RatExpansion(big.NewRat(1, 7), 5)
+	su.False(ok, "the repetend of 1/7 has 6 digits")
+
+	_, _, ok = RatExpansion(big.NewRat(1, 7), 6)
+	su.True(ok)
+}
+
+func (su *DecimalSuite) TestDivExact() {
+	q, exact := Require("1").DivExact(Require("8"))
+	su.True(exact)
+	su.Equal("0.125", q.String())
+
+	q, exact = Require("1").DivExact(Require("3"))
+	su.False(exact)
+	su.Equal(Require("1").Div(Require("3")), q)
+
+	q, exact = Require("1").DivExact(Require("1024"))
+	su.True(exact, "more digits than DivisionPrecision")
+	su.Equal("0.0009765625", q.String())
+
+	q, exact = Require("1").DivExact(Require("2").Pow(Require("60")))
+	su.True(exact)
+	su.Equal("0.000000000000000000867361737988403547205962240695953369140625", q.String())
+
+	su.Panics(func() { Require("1").DivExact(Zero) })
+}
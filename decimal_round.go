@@ -0,0 +1,178 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RoundingMode specifies how a value is rounded when it can not be represented exactly.
+type RoundingMode uint8
+
+const (
+	// RoundingHalfUp rounds half away from zero, the same as Round.
+	RoundingHalfUp RoundingMode = iota
+	// RoundingHalfEven rounds half to the nearest even digit, the same as RoundBank.
+	RoundingHalfEven
+	// RoundingAwayFromZero rounds away from zero, the same as RoundAwayFromZero.
+	RoundingAwayFromZero
+	// RoundingTowardToZero rounds towards zero, the same as RoundTowardToZero.
+	RoundingTowardToZero
+	// RoundingCeil rounds towards +infinity, the same as Ceil.
+	RoundingCeil
+	// RoundingFloor rounds towards -infinity, the same as Floor.
+	RoundingFloor
+)
+
+var bigOne = big.NewInt(1)
+
+// RoundWithMode rounds the decimal to places decimal places with the given rounding mode.
+//
+// Example:
+//
+//	NewFromFloat(5.45).RoundWithMode(1, RoundingHalfUp).String()  // "5.5"
+//	NewFromFloat(-5.45).RoundWithMode(1, RoundingFloor).String()  // "-5.5"
+func (d Decimal) RoundWithMode(places int, mode RoundingMode) Decimal {
+	switch mode {
+	case RoundingHalfUp:
+		return d.Round(places)
+	case RoundingHalfEven:
+		return d.RoundBank(places)
+	case RoundingAwayFromZero:
+		return d.RoundAwayFromZero(places)
+	case RoundingTowardToZero:
+		return d.RoundTowardToZero(places)
+	case RoundingCeil:
+		return d.Ceil(places)
+	case RoundingFloor:
+		return d.Floor(places)
+	default:
+		panic(fmt.Sprintf("round: unknown rounding mode %d", mode))
+	}
+}
+
+// RoundToIncrement rounds the decimal to the nearest multiple of step with the given rounding mode.
+// step must be positive, otherwise it panics.
+//
+// Example:
+//
+//	Require("3.43").RoundToIncrement(Require("0.05"), RoundingHalfUp).String()     // "3.45"
+//	Require("1.1234").RoundToIncrement(Require("0.0005"), RoundingFloor).String() // "1.123"
+//	Require("-7").RoundToIncrement(Require("0.25"), RoundingCeil).String()        // "-7"
+func (d Decimal) RoundToIncrement(step Decimal, mode RoundingMode) Decimal {
+	return Decimal(roundToIncrement(normalize([]byte(d)), normalize([]byte(step)), mode))
+}
+
+// RoundCash aka Cash/Penny/öre rounding rounds decimal to a specific interval.
+// The amount payable for a cash transaction is rounded to the nearest multiple of
+// the minimum currency unit available.
+//
+// The following intervals are available: 5, 10, 25, 50 and 100; any other number panics.
+//
+//	  5:   5 cent rounding 3.43 => 3.45
+//	 10:  10 cent rounding 3.45 => 3.50 (5 gets rounded up)
+//	 25:  25 cent rounding 3.41 => 3.50
+//	 50:  50 cent rounding 3.75 => 4.00
+//	100: 100 cent rounding 3.50 => 4.00
+func (d Decimal) RoundCash(interval uint8) Decimal {
+	switch interval {
+	case 5, 10, 25, 50, 100:
+	default:
+		panic(fmt.Sprintf("round cash: unsupported interval %d", interval))
+	}
+
+	return d.RoundToIncrement(NewFromInt(int64(interval)).Shift(-2), RoundingHalfUp)
+}
+
+// StringFixedCash returns a Swedish/Cash rounded fixed-point string with two digits after the decimal point.
+// For more details see the documentation of RoundCash.
+//
+// Example:
+//
+//	Require("3.43").StringFixedCash(5)   // "3.45"
+//	Require("3.75").StringFixedCash(50)  // "4.00"
+func (d Decimal) StringFixedCash(interval uint8) string {
+	return d.RoundCash(interval).StringFixed(2)
+}
+
+// StringFixedBank returns a banker rounded fixed-point string with places digits after the decimal point.
+//
+// Example:
+//
+//	NewFromFloat(5.45).StringFixedBank(1) // "5.4"
+//	NewFromFloat(5.46).StringFixedBank(3) // "5.460"
+//	NewFromFloat(545).StringFixedBank(-1) // "540"
+func (d Decimal) StringFixedBank(places int) string {
+	return d.RoundBank(places).StringFixed(places)
+}
+
+func roundToIncrement(buf, step []byte, mode RoundingMode) []byte {
+	if sign(step) <= 0 {
+		panic("round to increment: step must be positive")
+	}
+
+	x, xScale := bigIntWithScale(buf)
+	y, yScale := bigIntWithScale(step)
+
+	// bring both numbers to the same scale so that x / y is a plain integer division
+	scale := max(xScale, yScale)
+	if xScale < scale {
+		x.Mul(x, pow10(scale-xScale))
+	}
+	if yScale < scale {
+		y.Mul(y, pow10(scale-yScale))
+	}
+
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	q = roundQuotient(q, r, y, mode)
+
+	return tidyBytes(shift([]byte(q.Mul(q, y).String()), -scale))
+}
+
+// roundQuotient adjusts the truncated quotient q of x / y according to mode,
+// where r is the remainder which has the same sign as x. y must be positive.
+func roundQuotient(q, r, y *big.Int, mode RoundingMode) *big.Int {
+	if r.Sign() == 0 {
+		return q
+	}
+
+	neg := r.Sign() < 0
+	away := false
+	switch mode {
+	case RoundingHalfUp, RoundingHalfEven:
+		var twice big.Int
+		c := twice.Lsh(twice.Abs(r), 1).Cmp(y)
+		away = c > 0 || (c == 0 && (mode == RoundingHalfUp || q.Bit(0) == 1))
+	case RoundingAwayFromZero:
+		away = true
+	case RoundingTowardToZero:
+	case RoundingCeil:
+		away = !neg
+	case RoundingFloor:
+		away = neg
+	default:
+		panic(fmt.Sprintf("round: unknown rounding mode %d", mode))
+	}
+
+	if !away {
+		return q
+	}
+
+	if neg {
+		return q.Sub(q, bigOne)
+	}
+
+	return q.Add(q, bigOne)
+}
+
+// bigIntWithScale returns the digits of buf as a big.Int and the count of the digit right the decimal.
+//
+// NOTE: REMOVE THE DECIMAL POINT OF buf IN PLACE
+func bigIntWithScale(buf []byte) (*big.Int, int) {
+	digits, scale := removeDecimalPoint(buf)
+	i, ok := new(big.Int).SetString(string(digits), 10)
+	if !ok {
+		panic("convert decimal to big int")
+	}
+
+	return i, scale
+}
@@ -0,0 +1,120 @@
+package decimal
+
+import (
+	"testing"
+)
+
+func (su *DecimalSuite) TestRoundWithMode() {
+	testCases := []struct {
+		desc     string
+		input    string
+		places   int
+		mode     RoundingMode
+		expected string
+	}{
+		{"Half Up", "5.45", 1, RoundingHalfUp, "5.5"},
+		{"Half Up Negative", "-5.45", 1, RoundingHalfUp, "-5.5"},
+		{"Half Even", "5.45", 1, RoundingHalfEven, "5.4"},
+		{"Away From Zero", "1.105", 2, RoundingAwayFromZero, "1.11"},
+		{"Toward To Zero", "-1.454", 1, RoundingTowardToZero, "-1.4"},
+		{"Ceil", "-1.454", 1, RoundingCeil, "-1.4"},
+		{"Floor", "-1.454", 1, RoundingFloor, "-1.5"},
+		{"Negative Places", "545", -1, RoundingHalfEven, "540"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			result := Require(tc.input).RoundWithMode(tc.places, tc.mode)
+			su.Equal(tc.expected, result.String(), tc.desc)
+		})
+	}
+}
+
+func (su *DecimalSuite) TestRoundToIncrement() {
+	testCases := []struct {
+		desc     string
+		input    string
+		step     string
+		mode     RoundingMode
+		expected string
+	}{
+		{"Nickel", "3.43", "0.05", RoundingHalfUp, "3.45"},
+		{"Nickel Down", "3.42", "0.05", RoundingHalfUp, "3.4"},
+		{"Nickel Half", "3.425", "0.05", RoundingHalfUp, "3.45"},
+		{"Nickel Half Even", "3.425", "0.05", RoundingHalfEven, "3.4"},
+		{"Nickel Half Even Odd", "3.475", "0.05", RoundingHalfEven, "3.5"},
+		{"Quarter", "3.41", "0.25", RoundingHalfUp, "3.5"},
+		{"Quarter Negative", "-3.41", "0.25", RoundingHalfUp, "-3.5"},
+		{"Tick Floor", "1.1234", "0.0005", RoundingFloor, "1.123"},
+		{"Tick Ceil", "1.1231", "0.0005", RoundingCeil, "1.1235"},
+		{"Tick Ceil Negative", "-1.1234", "0.0005", RoundingCeil, "-1.123"},
+		{"Tick Floor Negative", "-1.1231", "0.0005", RoundingFloor, "-1.1235"},
+		{"Away From Zero", "-1.0001", "0.25", RoundingAwayFromZero, "-1.25"},
+		{"Toward To Zero", "-1.2499", "0.25", RoundingTowardToZero, "-1"},
+		{"Already Multiple", "-7", "0.25", RoundingCeil, "-7"},
+		{"Integer Step", "1234", "50", RoundingHalfUp, "1250"},
+		{"Integer Step Below Half", "1224", "50", RoundingHalfUp, "1200"},
+		{"Zero", "0", "0.05", RoundingAwayFromZero, "0"},
+		{"To Zero", "0.01", "0.05", RoundingHalfUp, "0"},
+		{"Long Fraction", "0.123456789123456789", "0.000000001", RoundingHalfUp, "0.123456789"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			result := Require(tc.input).RoundToIncrement(Require(tc.step), tc.mode)
+			su.Equal(tc.expected, result.String(), tc.desc)
+		})
+	}
+
+	su.Panics(func() { Require("1").RoundToIncrement(Zero, RoundingHalfUp) })
+	su.Panics(func() { Require("1").RoundToIncrement(Require("-0.05"), RoundingHalfUp) })
+}
+
+func (su *DecimalSuite) TestRoundCash() {
+	testCases := []struct {
+		desc     string
+		input    string
+		interval uint8
+		expected string
+		fixed    string
+	}{
+		{"5", "3.43", 5, "3.45", "3.45"},
+		{"5 Down", "3.42", 5, "3.4", "3.40"},
+		{"10", "3.45", 10, "3.5", "3.50"},
+		{"25", "3.41", 25, "3.5", "3.50"},
+		{"50", "3.75", 50, "4", "4.00"},
+		{"100", "3.5", 100, "4", "4.00"},
+		{"100 Negative", "-3.5", 100, "-4", "-4.00"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			d := Require(tc.input)
+			su.Equal(tc.expected, d.RoundCash(tc.interval).String(), tc.desc)
+			su.Equal(tc.fixed, d.StringFixedCash(tc.interval), tc.desc)
+		})
+	}
+
+	su.Panics(func() { Require("1").RoundCash(20) })
+}
+
+func (su *DecimalSuite) TestStringFixedBank() {
+	testCases := []struct {
+		desc     string
+		input    string
+		places   int
+		expected string
+	}{
+		{"Half Even Down", "5.45", 1, "5.4"},
+		{"Half Even Up", "5.55", 1, "5.6"},
+		{"Padding", "5.46", 3, "5.460"},
+		{"Zero", "0", 2, "0.00"},
+		{"Negative Places", "545", -1, "540"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			su.Equal(tc.expected, Require(tc.input).StringFixedBank(tc.places), tc.desc)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package decimal
+
+import (
+	"database/sql/driver"
+	"math/big"
+)
+
+// Exponent returns the exponent, or scale component of the decimal.
+// The exponent is derived from the count of the digit right the decimal point,
+// so the trailing zeroes are counted in.
+//
+// Example:
+//
+//	Decimal("1.50").Exponent()    // -2
+//	Require("1.50").Exponent()    // -1
+//	Require("1500").Exponent()    // 0
+//	Require("1.5").Rescale(-3).Exponent() // -3
+func (d Decimal) Exponent() int32 {
+	return int32(-rawScale(d))
+}
+
+// Coefficient returns the coefficient of the decimal. It is scaled by 10^Exponent()
+//
+// Example:
+//
+//	Decimal("1.50").Coefficient() // 150
+//	Require("-1.5").Coefficient() // -15
+func (d Decimal) Coefficient() *big.Int {
+	c, scale := bigIntWithScale(normalize([]byte(d)))
+	if exp := rawScale(d); exp > scale {
+		c.Mul(c, pow10(exp-scale))
+	}
+
+	return c
+}
+
+// CoefficientInt64 returns the coefficient of the decimal as int64. It is scaled by 10^Exponent()
+//
+// NOTE: If the coefficient can not be represented in an int64, the result will be undefined.
+func (d Decimal) CoefficientInt64() int64 {
+	return d.Coefficient().Int64()
+}
+
+// Rescale returns the decimal with the given exponent, the digits out of the exponent are truncated.
+//
+// When exp is positive, the value is truncated to a multiple of 10^exp. The string of the result
+// can't keep a positive exponent, so the Exponent of the result is 0.
+//
+// NOTE: the trailing zeroes are kept in the result, but they are trimmed by String and the arithmetic operations,
+// use WithScale to carry the scale.
+//
+// Example:
+//
+//	Require("1.5").Rescale(-3).Coefficient()   // 1500
+//	Require("1.567").Rescale(-2).Coefficient() // 156
+//	Require("1567").Rescale(2).String()        // "1500"
+func (d Decimal) Rescale(exp int) Decimal {
+	if exp <= 0 {
+		return Decimal(d.StringFixed(-exp))
+	}
+
+	c, scale := bigIntWithScale(normalize([]byte(d)))
+	c.Quo(c, pow10(scale+exp))
+
+	return Decimal(tidyBytes(shift([]byte(c.String()), exp)))
+}
+
+// RescalePair rescales two decimals to the common exponent, which is the smaller one of them.
+//
+// Example:
+//
+//	d1, d2 := RescalePair(Require("1.5"), Decimal("2.250"))
+//	d1.Coefficient() // 1500
+//	d2.Coefficient() // 2250
+func RescalePair(d1 Decimal, d2 Decimal) (Decimal, Decimal) {
+	exp := int(min(d1.Exponent(), d2.Exponent()))
+	return d1.Rescale(exp), d2.Rescale(exp)
+}
+
+// ScaledDecimal is a decimal with an explicit scale, the count of the digit right the decimal point.
+// The scale survives the arithmetic operations with the SQL rules, and is emitted by String, Value and MarshalText.
+//
+//	Add, Sub: max(scale1, scale2)
+//	Mul:      scale1 + scale2
+//	Round:    places
+//
+// The zero value is 0 with scale 0.
+//
+// Example:
+//
+//	a := decimal.Require("1.25").WithScale(2)
+//	a.Add(decimal.Require("1.75").WithScale(2)).String() // "3.00"
+//	a.Mul(decimal.Require("2").WithScale(1)).String()    // "2.500"
+type ScaledDecimal struct {
+	value Decimal
+	scale int
+}
+
+// WithScale returns the decimal rounded to scale decimal places with the scale.
+//
+// When scale is negative, the decimal is rounded to a multiple of 10^-scale, and the scale is 0.
+//
+// Example:
+//
+//	Require("2.5").WithScale(3).String()    // "2.500"
+//	Require("2.5678").WithScale(2).String() // "2.57"
+//	Require("1567").WithScale(-2).String()  // "1600"
+func (d Decimal) WithScale(scale int) ScaledDecimal {
+	return ScaledDecimal{value: d.Round(scale), scale: max(scale, 0)}
+}
+
+// NewScaled returns a ScaledDecimal from a string representation, the scale is the count of the digit
+// right the decimal point in value, so the trailing zeroes are kept.
+//
+// Acceptable symbol (+-.,_0123456789)
+//
+// Example:
+//
+//	s, _ := decimal.NewScaled("100.00")
+//	s.Scale()  // 2
+//	s.String() // "100.00"
+func NewScaled(value string) (ScaledDecimal, error) {
+	d, err := New(value)
+	if err != nil {
+		return ScaledDecimal{}, err
+	}
+
+	return ScaledDecimal{value: d, scale: rawScale(value)}, nil
+}
+
+// RequireScaled returns a ScaledDecimal from a string representation or panics if NewScaled would have returned an error.
+//
+// Acceptable symbol (+-.,_0123456789)
+func RequireScaled(value string) ScaledDecimal {
+	s, err := NewScaled(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// Decimal returns the value without the scale.
+func (s ScaledDecimal) Decimal() Decimal {
+	return s.value
+}
+
+// Scale returns the count of the digit right the decimal point.
+func (s ScaledDecimal) Scale() int {
+	return s.scale
+}
+
+// String returns the string representation of the decimal with the trailing zeroes to the scale.
+func (s ScaledDecimal) String() string {
+	if len(s.value) == 0 {
+		return Zero.StringFixed(s.scale)
+	}
+
+	return s.value.StringFixed(s.scale)
+}
+
+// Add returns s + s2 with the larger scale of them.
+func (s ScaledDecimal) Add(s2 ScaledDecimal) ScaledDecimal {
+	return ScaledDecimal{value: s.value.Add(s2.value), scale: max(s.scale, s2.scale)}
+}
+
+// Sub returns s - s2 with the larger scale of them.
+func (s ScaledDecimal) Sub(s2 ScaledDecimal) ScaledDecimal {
+	return ScaledDecimal{value: s.value.Sub(s2.value), scale: max(s.scale, s2.scale)}
+}
+
+// Mul returns s * s2 with the sum of their scales.
+func (s ScaledDecimal) Mul(s2 ScaledDecimal) ScaledDecimal {
+	return ScaledDecimal{value: s.value.Mul(s2.value), scale: s.scale + s2.scale}
+}
+
+// Round rounds the decimal to places decimal places, and the scale of the result is places.
+func (s ScaledDecimal) Round(places int) ScaledDecimal {
+	return s.value.WithScale(places)
+}
+
+// Neg returns -s with the same scale.
+func (s ScaledDecimal) Neg() ScaledDecimal {
+	return ScaledDecimal{value: s.value.Neg(), scale: s.scale}
+}
+
+// Abs returns the absolute value of s with the same scale.
+func (s ScaledDecimal) Abs() ScaledDecimal {
+	return ScaledDecimal{value: s.value.Abs(), scale: s.scale}
+}
+
+// Cmp compares the values of s and s2, the scales are ignored.
+//
+//	-1 if s <  s2
+//	 0 if s == s2
+//	+1 if s >  s2
+func (s ScaledDecimal) Cmp(s2 ScaledDecimal) int {
+	return s.value.Cmp(s2.value)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface, the trailing zeroes to the scale are kept.
+func (s ScaledDecimal) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, the scale is the count of the digit
+// right the decimal point in text.
+func (s *ScaledDecimal) UnmarshalText(text []byte) error {
+	scaled, err := NewScaled(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = scaled
+	return nil
+}
+
+// Scan implements the sql.Scanner interface for database deserialization.
+//
+// The scale of a string value, like a NUMERIC column, is the count of the digit right the decimal point,
+// the others take the scale of the shortest decimal representation.
+func (s *ScaledDecimal) Scan(value any) error {
+	switch v := value.(type) {
+	case string:
+		return s.UnmarshalText([]byte(v))
+	case []byte:
+		return s.UnmarshalText(v)
+	}
+
+	var d Decimal
+	if err := d.Scan(value); err != nil {
+		return err
+	}
+
+	*s = ScaledDecimal{value: d, scale: rawScale(d)}
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database writes, the trailing zeroes to the scale are kept.
+func (s ScaledDecimal) Value() (driver.Value, error) {
+	return s.String(), nil
+}
+
+// rawScale returns the count of the digit right the decimal point in s without normalizing.
+//
+// NOTE: NO COPY
+func rawScale[T ~string | ~[]byte](s T) int {
+	scale := -1
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '.':
+			scale = 0
+		case scale >= 0 && c >= '0' && c <= '9':
+			scale++
+		}
+	}
+
+	return max(scale, 0)
+}
@@ -0,0 +1,185 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func (su *DecimalSuite) TestExponentAndCoefficient() {
+	testCases := []struct {
+		desc        string
+		input       Decimal
+		exponent    int32
+		coefficient string
+	}{
+		{"Integer", Decimal("1500"), 0, "1500"},
+		{"Fraction", Decimal("1.5"), -1, "15"},
+		{"Trailing Zero", Decimal("1.50"), -2, "150"},
+		{"Negative", Decimal("-0.025"), -3, "-25"},
+		{"Zero", Decimal("0"), 0, "0"},
+		{"Zero With Scale", Decimal("0.000"), -3, "0"},
+		{"Empty", Decimal(""), 0, "0"},
+		{"Separator", Decimal("1,000.000_1"), -4, "10000001"},
+		{"Normalized", Require("1.50"), -1, "15"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			su.Equal(tc.exponent, tc.input.Exponent(), tc.desc)
+			su.Equal(tc.coefficient, tc.input.Coefficient().String(), tc.desc)
+			su.Equal(tc.input.Coefficient().Int64(), tc.input.CoefficientInt64(), tc.desc)
+		})
+	}
+}
+
+func (su *DecimalSuite) TestRescale() {
+	testCases := []struct {
+		desc     string
+		input    string
+		exp      int
+		expected string
+	}{
+		{"Extend", "1.5", -3, "1.500"},
+		{"Truncate", "1.567", -2, "1.56"},
+		{"Truncate Negative", "-1.567", -2, "-1.56"},
+		{"Integer", "1.567", 0, "1"},
+		{"Positive Exponent", "1567", 2, "1500"},
+		{"Positive Exponent Negative", "-1567.89", 2, "-1500"},
+		{"Positive Exponent Overflow", "99", 2, "0"},
+		{"Zero", "0", -2, "0.00"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			result := Require(tc.input).Rescale(tc.exp)
+			su.Equal(tc.expected, string(result), tc.desc)
+			if tc.exp <= 0 {
+				su.Equal(int32(tc.exp), result.Exponent(), tc.desc)
+			}
+		})
+	}
+}
+
+func (su *DecimalSuite) TestRescalePair() {
+	d1, d2 := RescalePair(Require("1.5"), Decimal("2.250"))
+	su.Equal("1.500", string(d1))
+	su.Equal("2.250", string(d2))
+	su.Equal(d1.Exponent(), d2.Exponent())
+
+	d1, d2 = RescalePair(Require("100"), Require("-0.01"))
+	su.Equal("100.00", string(d1))
+	su.Equal("-0.01", string(d2))
+}
+
+func (su *DecimalSuite) TestWithScale() {
+	testCases := []struct {
+		desc     string
+		input    string
+		scale    int
+		expected string
+	}{
+		{"Extend", "2.5", 3, "2.500"},
+		{"Round", "2.5678", 2, "2.57"},
+		{"Round Carry", "9.999", 2, "10.00"},
+		{"Integer", "2.5", 0, "3"},
+		{"Negative Scale", "1567", -2, "1600"},
+		{"Zero", "0", 2, "0.00"},
+		{"Negative", "-0.5", 2, "-0.50"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			su.Equal(tc.expected, Require(tc.input).WithScale(tc.scale).String(), tc.desc)
+		})
+	}
+
+	su.Equal("0", ScaledDecimal{}.String())
+	su.Equal("1.5", Require("2.5").WithScale(3).Sub(Require("1").WithScale(0)).Decimal().String())
+}
+
+func (su *DecimalSuite) TestScaledDecimal() {
+	testCases := []struct {
+		desc     string
+		calc     func() ScaledDecimal
+		expected string
+		scale    int
+	}{
+		{"Add", func() ScaledDecimal { return Require("1.25").WithScale(2).Add(RequireScaled("1.75")) }, "3.00", 2},
+		{"Add Larger Scale", func() ScaledDecimal { return RequireScaled("1.50").Add(RequireScaled("2.125")) }, "3.625", 3},
+		{"Add Integer", func() ScaledDecimal { return RequireScaled("1.50").Add(RequireScaled("2")) }, "3.50", 2},
+		{"Sub", func() ScaledDecimal { return RequireScaled("1.25").Sub(RequireScaled("0.25")) }, "1.00", 2},
+		{"Sub Zero", func() ScaledDecimal { return RequireScaled("1.50").Sub(RequireScaled("1.5")) }, "0.00", 2},
+		{"Sub Negative", func() ScaledDecimal { return RequireScaled("1.5").Sub(RequireScaled("2.000")) }, "-0.500", 3},
+		{"Mul", func() ScaledDecimal { return Require("2.5").WithScale(3).Mul(RequireScaled("2")) }, "5.000", 3},
+		{"Mul Scale Sum", func() ScaledDecimal { return RequireScaled("1.25").Mul(RequireScaled("1.20")) }, "1.5000", 4},
+		{"Mul Zero", func() ScaledDecimal { return RequireScaled("0.00").Mul(RequireScaled("-1.5")) }, "0.000", 3},
+		{"Round", func() ScaledDecimal { return RequireScaled("1.999").Round(2) }, "2.00", 2},
+		{"Round Extend", func() ScaledDecimal { return RequireScaled("1.25").Round(4) }, "1.2500", 4},
+		{"Neg", func() ScaledDecimal { return RequireScaled("1.50").Neg() }, "-1.50", 2},
+		{"Abs", func() ScaledDecimal { return RequireScaled("-1.50").Abs() }, "1.50", 2},
+		{"New Separator", func() ScaledDecimal { return RequireScaled("-1,000.500") }, "-1000.500", 3},
+		{"New Zero", func() ScaledDecimal { return RequireScaled("-0.00") }, "0.00", 2},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			result := tc.calc()
+			su.Equal(tc.expected, result.String(), tc.desc)
+			su.Equal(tc.scale, result.Scale(), tc.desc)
+		})
+	}
+
+	su.Equal(0, RequireScaled("1.50").Cmp(RequireScaled("1.5")))
+	su.Equal(-1, RequireScaled("-1.50").Cmp(RequireScaled("1.5")))
+
+	_, err := NewScaled("1.2.3")
+	su.Error(err)
+
+	// the plain Decimal keeps trimming the trailing zeroes
+	su.Equal("1.5", Decimal("1.50").String())
+	su.Equal("3", Require("1.25").Add(Require("1.75")).String())
+}
+
+func (su *DecimalSuite) TestScaledDecimalRoundTrip() {
+	testCases := []struct {
+		desc     string
+		input    any
+		expected string
+		scale    int
+	}{
+		{"Numeric", "12.50", "12.50", 2},
+		{"Numeric Bytes", []byte("-0.100"), "-0.100", 3},
+		{"Numeric Integer", "100", "100", 0},
+		{"Int64", int64(12), "12", 0},
+		{"Float64", 12.5, "12.5", 1},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			var s ScaledDecimal
+			su.Require().NoError(s.Scan(tc.input))
+			su.Equal(tc.expected, s.String(), tc.desc)
+			su.Equal(tc.scale, s.Scale(), tc.desc)
+
+			value, err := s.Value()
+			su.Require().NoError(err)
+			su.Equal(tc.expected, value, tc.desc)
+
+			var again ScaledDecimal
+			su.Require().NoError(again.Scan(value))
+			su.Equal(s, again, tc.desc)
+		})
+	}
+
+	var s ScaledDecimal
+	su.Error(s.Scan(true))
+	su.Error(s.Scan("abc"))
+
+	b, err := json.Marshal(struct{ Amount ScaledDecimal }{RequireScaled("100.00")})
+	su.Require().NoError(err)
+	su.Equal(`{"Amount":"100.00"}`, string(b))
+
+	var v struct{ Amount ScaledDecimal }
+	su.Require().NoError(json.Unmarshal(b, &v))
+	su.Equal("100.00", v.Amount.String())
+}
@@ -0,0 +1,193 @@
+package decimal
+
+import (
+	"runtime"
+	"sync"
+)
+
+// SliceOptions is the options of the slice functions, the zero value handles the slices in the calling goroutine,
+// the same as the package functions AddSlices, MulSlices, ScaleSlice, SumSlice and Dot.
+//
+// Example:
+//
+//	opts := decimal.SliceOptions{ParallelThreshold: 10_000}
+//	opts.Dot(qty, price)
+type SliceOptions struct {
+	// ParallelThreshold is the min length of the slices from which the work is split across goroutines,
+	// 0 disables the parallelism.
+	ParallelThreshold int
+}
+
+// AddSlices returns the element-wise sum a[i] + b[i] in dst, the same as Add for each element.
+// dst is reused when it has enough capacity, and it may be a or b. It panics when the lengths of a and b are different.
+//
+// Example:
+//
+//	decimal.AddSlices(nil, []Decimal{"1", "2.5"}, []Decimal{"0.5", "-1"}) // ["1.5", "1.5"]
+func AddSlices(dst, a, b []Decimal) []Decimal {
+	return SliceOptions{}.AddSlices(dst, a, b)
+}
+
+// MulSlices returns the element-wise product a[i] * b[i] in dst, the same as Mul for each element.
+// dst is reused when it has enough capacity, and it may be a or b. It panics when the lengths of a and b are different.
+//
+// Example:
+//
+//	decimal.MulSlices(nil, []Decimal{"10", "2.5"}, []Decimal{"1.5", "4"}) // ["15", "10"]
+func MulSlices(dst, a, b []Decimal) []Decimal {
+	return SliceOptions{}.MulSlices(dst, a, b)
+}
+
+// ScaleSlice returns s[i] * factor in dst. dst is reused when it has enough capacity, and it may be s.
+//
+// Example:
+//
+//	decimal.ScaleSlice(nil, []Decimal{"10", "2.5"}, "0.5") // ["5", "1.25"]
+func ScaleSlice(dst, s []Decimal, factor Decimal) []Decimal {
+	return SliceOptions{}.ScaleSlice(dst, s, factor)
+}
+
+// SumSlice returns the sum of the decimals, 0 for an empty slice.
+//
+// Example:
+//
+//	decimal.SumSlice([]Decimal{"1.5", "2", "-0.25"}) // "3.25"
+func SumSlice(s []Decimal) Decimal {
+	return SliceOptions{}.SumSlice(s)
+}
+
+// Dot returns the dot product of a and b, which is the sum of a[i] * b[i], 0 for empty slices.
+// It panics when the lengths of a and b are different.
+//
+// Example:
+//
+//	qty := []Decimal{"10", "2.5"}
+//	price := []Decimal{"1.5", "4"}
+//	decimal.Dot(qty, price) // "25"
+func Dot(a, b []Decimal) Decimal {
+	return SliceOptions{}.Dot(a, b)
+}
+
+// AddSlices is the same as the package function AddSlices with the options.
+func (o SliceOptions) AddSlices(dst, a, b []Decimal) []Decimal {
+	if len(a) != len(b) {
+		panic("add slices: length mismatch")
+	}
+
+	dst = resizeSlice(dst, len(a))
+	o.parallelChunks(len(a), func(start, end int) {
+		var acc Accumulator
+		for i := start; i < end; i++ {
+			acc.Set(a[i])
+			acc.AddInPlace(b[i])
+			dst[i] = acc.Decimal()
+		}
+	})
+
+	return dst
+}
+
+// MulSlices is the same as the package function MulSlices with the options.
+func (o SliceOptions) MulSlices(dst, a, b []Decimal) []Decimal {
+	if len(a) != len(b) {
+		panic("mul slices: length mismatch")
+	}
+
+	dst = resizeSlice(dst, len(a))
+	o.parallelChunks(len(a), func(start, end int) {
+		var acc Accumulator
+		for i := start; i < end; i++ {
+			acc.Set(a[i])
+			acc.MulInPlace(b[i])
+			dst[i] = acc.Decimal()
+		}
+	})
+
+	return dst
+}
+
+// ScaleSlice is the same as the package function ScaleSlice with the options.
+func (o SliceOptions) ScaleSlice(dst, s []Decimal, factor Decimal) []Decimal {
+	dst = resizeSlice(dst, len(s))
+	o.parallelChunks(len(s), func(start, end int) {
+		var acc Accumulator
+		for i := start; i < end; i++ {
+			acc.Set(s[i])
+			acc.MulInPlace(factor)
+			dst[i] = acc.Decimal()
+		}
+	})
+
+	return dst
+}
+
+// SumSlice is the same as the package function SumSlice with the options.
+func (o SliceOptions) SumSlice(s []Decimal) Decimal {
+	return o.reduceChunks(len(s), func(acc, _ *Accumulator, i int) {
+		acc.AddInPlace(s[i])
+	})
+}
+
+// Dot is the same as the package function Dot with the options.
+func (o SliceOptions) Dot(a, b []Decimal) Decimal {
+	if len(a) != len(b) {
+		panic("dot: length mismatch")
+	}
+
+	return o.reduceChunks(len(a), func(acc, scratch *Accumulator, i int) {
+		acc.addProduct(a[i], b[i], scratch)
+	})
+}
+
+// resizeSlice returns dst with length n, it's reallocated when the capacity is not enough.
+func resizeSlice(dst []Decimal, n int) []Decimal {
+	if cap(dst) < n {
+		return make([]Decimal, n)
+	}
+
+	return dst[:n]
+}
+
+// reduceChunks sums the values added by f for the index 0 ~ n-1 into an accumulator for each chunk,
+// and returns the total of the chunks.
+func (o SliceOptions) reduceChunks(n int, f func(acc, scratch *Accumulator, i int)) Decimal {
+	var (
+		mu    sync.Mutex
+		total Accumulator
+	)
+
+	o.parallelChunks(n, func(start, end int) {
+		var acc, scratch Accumulator
+		for i := start; i < end; i++ {
+			f(&acc, &scratch, i)
+		}
+
+		mu.Lock()
+		total.addAccumulator(&acc)
+		mu.Unlock()
+	})
+
+	return total.Decimal()
+}
+
+// parallelChunks calls f with the chunks of the index 0 ~ n-1,
+// the chunks are handled by goroutines when n reaches ParallelThreshold.
+func (o SliceOptions) parallelChunks(n int, f func(start, end int)) {
+	workers := runtime.GOMAXPROCS(0)
+	if o.ParallelThreshold <= 0 || n < o.ParallelThreshold || workers == 1 {
+		f(0, n)
+		return
+	}
+
+	chunk := (n + workers - 1) / workers
+	wg := sync.WaitGroup{}
+	for start := 0; start < n; start += chunk {
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			f(start, end)
+		}(start, min(start+chunk, n))
+	}
+
+	wg.Wait()
+}
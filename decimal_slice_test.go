@@ -0,0 +1,76 @@
+package decimal
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func (su *DecimalSuite) TestSliceOperations() {
+	r := rand.New(rand.NewSource(36))
+	a, b := make([]Decimal, 1000), make([]Decimal, 1000)
+	for i := range a {
+		a[i], b[i] = randomDecimal(r, 20), randomDecimal(r, 20)
+	}
+
+	factor := Require("-1.25")
+	sum, dot := Zero, Zero
+	for i := range a {
+		sum = sum.Add(a[i])
+		dot = dot.Add(a[i].Mul(b[i]))
+	}
+
+	for _, opts := range []SliceOptions{{}, {ParallelThreshold: 10}} {
+		su.T().Run("Threshold", func(t *testing.T) {
+			added, multiplied, scaled := opts.AddSlices(nil, a, b), opts.MulSlices(nil, a, b), opts.ScaleSlice(nil, a, factor)
+			for i := range a {
+				su.Require().Equal(a[i].Add(b[i]).String(), added[i].String(), "%s + %s", a[i], b[i])
+				su.Require().True(a[i].Mul(b[i]).Equal(multiplied[i]), "%s * %s", a[i], b[i])
+				su.Require().True(a[i].Mul(factor).Equal(scaled[i]), "%s * %s", a[i], factor)
+			}
+
+			su.Equal(sum.String(), opts.SumSlice(a).String())
+			su.Equal(dot.String(), opts.Dot(a, b).String())
+		})
+	}
+}
+
+func (su *DecimalSuite) TestSliceOperationsEdge() {
+	su.Equal("0", SumSlice(nil).String())
+	su.Equal("0", Dot(nil, nil).String())
+	su.Empty(AddSlices(nil, nil, nil))
+
+	qty := []Decimal{"10", "2.5", "-3"}
+	price := []Decimal{"1.5", "4", "0.1"}
+	su.Equal("24.7", Dot(qty, price).String())
+
+	// dst aliases the input
+	dst := AddSlices(qty, qty, price)
+	su.Equal([]Decimal{"11.5", "6.5", "-2.9"}, dst)
+	su.Equal(&qty[0], &dst[0])
+
+	su.Equal([]Decimal{"5.75", "3.25", "-1.45"}, ScaleSlice(dst, dst, "0.5"))
+
+	su.Panics(func() { AddSlices(nil, qty, price[:1]) })
+	su.Panics(func() { MulSlices(nil, qty, price[:1]) })
+	su.Panics(func() { Dot(qty, price[:1]) })
+}
+
+func TestDotAllocs(t *testing.T) {
+	qty := []Decimal{"10", "2.5", "-3", "1000.125"}
+	price := []Decimal{"1.5", "4", "0.1", "-99.99"}
+	allocs := func(n int) float64 {
+		a, b := make([]Decimal, 0, n*len(qty)), make([]Decimal, 0, n*len(price))
+		for i := 0; i < n; i++ {
+			a, b = append(a, qty...), append(b, price...)
+		}
+
+		return testing.AllocsPerRun(10, func() {
+			_ = Dot(a, b)
+		})
+	}
+
+	// the scratch buffers are reused across the elements, only the growth of the buffers allocates
+	if small, large := allocs(1), allocs(100); large > 2*small {
+		t.Fatalf("Dot allocates %v times for 400 elements, %v times for 4 elements", large, small)
+	}
+}
@@ -11,8 +11,9 @@ func (d *Decimal) Scan(value any) error {
 	// first try to see if the data is stored in database as a Numeric datatype
 	switch v := value.(type) {
 	case float32:
+		// format with bitSize 32 for the shortest digits which round trip to the float32
 		var err error
-		*d, err = New(strconv.FormatFloat(float64(v), 'f', -1, 64))
+		*d, err = New(strconv.FormatFloat(float64(v), 'f', -1, 32))
 		return err
 	case float64:
 		var err error
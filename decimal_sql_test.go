@@ -0,0 +1,34 @@
+package decimal
+
+import (
+	"math"
+	"testing"
+)
+
+func (su *DecimalSuite) TestScan() {
+	testCases := []struct {
+		desc     string
+		value    any
+		expected string
+	}{
+		{"Float32", float32(0.1), "0.1"},
+		{"Float32 Negative", float32(-123.456), "-123.456"},
+		{"Float64", 0.1, "0.1"},
+		{"Int64", int64(-42), "-42"},
+		{"String", "1,000.50", "1000.5"},
+		{"Bytes", []byte("-0.25"), "-0.25"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			var d Decimal
+			su.Require().NoError(d.Scan(tc.value))
+			su.Equal(tc.expected, d.String(), tc.desc)
+		})
+	}
+
+	var d Decimal
+	su.Error(d.Scan(true))
+	su.Error(d.Scan(float32(math.NaN())))
+	su.Error(d.Scan("abc"))
+}
@@ -2,6 +2,7 @@ package decimal
 
 import (
 	"encoding/json"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -1008,6 +1009,10 @@ func (su *DecimalSuite) TestPow() {
 		{"2^-10", "2", "-10", "0.0009765625"},
 		{"2^100", "2", "100", "1267650600228229401496703205376"},
 		{"12^11", "12", "11", "743008370688"},
+		{"-3.5^3", "-3.5", "3", "-42.875"},
+		{"-1.5^2", "-1.5", "2", "2.25"},
+		{"1.00456888^7", "1.00456888", "7", "1.03242388133101414605845291292336460759007691336834875392"},
+		{"12345.6789^5", "12345.6789", "5", "286797186029971810723.37614380936720482949"},
 	}
 
 	for _, tc := range testCases {
@@ -1016,6 +1021,12 @@ func (su *DecimalSuite) TestPow() {
 			su.Equal(tc.expected, tc.d.Pow(tc.d2).String(), tc.desc)
 		})
 	}
+
+	su.Equal("1", Require("1").Pow(Require("1000000000000")).String())
+	su.Equal("-1", Require("-1").Pow(Require("1000000000001")).String())
+	su.Equal("0", Require("0").Pow(Require("1000000000000")).String())
+	su.PanicsWithValue("pow overflow", func() { Require("1.0000001").Pow(Require("100000000")) })
+	su.PanicsWithValue("pow overflow", func() { Require("0.1").Pow(Require("9223372036854775807")) })
 }
 
 func (su *DecimalSuite) TestIntPart() {
@@ -1861,6 +1872,28 @@ func (su *DecimalSuite) TestMultiplyPureNumber() {
 	}
 }
 
+func (su *DecimalSuite) TestMultiplyPureNumberLarge() {
+	for _, n := range []int{1, 17, multiplyBigThreshold - 1, multiplyBigThreshold, 100} {
+		for _, m := range []int{1, multiplyBigThreshold, 150} {
+			d1, d2 := make([]byte, n), make([]byte, m)
+			for i := range d1 {
+				d1[i] = '0' + byte(i*7+3)%10
+			}
+			for i := range d2 {
+				d2[i] = '0' + byte(i*3+5)%10
+			}
+
+			x, _ := new(big.Int).SetString(string(d1), 10)
+			y, _ := new(big.Int).SetString(string(d2), 10)
+			expected := x.Mul(x, y).String()
+
+			su.Equal(expected, string(tidyBytes(multiplySchoolbook(d1, d2))), "schoolbook %d * %d", n, m)
+			su.Equal(expected, string(tidyBytes(multiplyBig(d1, d2))), "big %d * %d", n, m)
+			su.Equal(expected, string(tidyBytes(multiplyPureNumber(d1, d2))), "dispatch %d * %d", n, m)
+		}
+	}
+}
+
 func (su *DecimalSuite) TestRemoveDecimalPoint() {
 	{
 		result, right := removeDecimalPoint([]byte("123.45678"))
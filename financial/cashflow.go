@@ -0,0 +1,204 @@
+package financial
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/yanun0323/decimal"
+)
+
+// daysPerYear is the count of the days of a year of XNPV and XIRR.
+var daysPerYear = decimal.Require("365")
+
+// CashFlow is an amount paid or received on a date.
+type CashFlow struct {
+	Date   time.Time
+	Amount decimal.Decimal
+}
+
+// NPV returns the net present value of the cash flows at the end of the equally spaced periods,
+// the first value is discounted by one period like the spreadsheet.
+//
+//	NPV = sum(values[i] / (1+rate)^(i+1))
+//
+// Example:
+//
+//	values := []decimal.Decimal{"-10000", "3000", "4200", "6800"}
+//	financial.NPV(decimal.Require("0.1"), values, opts) // "1188.44"
+func NPV(rate decimal.Decimal, values []decimal.Decimal, opts Options) (decimal.Decimal, error) {
+	if err := checkDiscountRate(rate); err != nil {
+		return decimal.Zero, err
+	}
+
+	// Horner's method from the last value, sum = (sum + values[i]) / (1+rate)
+	onePlusR := one.Add(rate).Rat()
+	sum := new(big.Rat)
+	for i := len(values) - 1; i >= 0; i-- {
+		sum.Add(sum, values[i].Rat())
+		sum.Quo(sum, onePlusR)
+	}
+
+	return round(sum, opts), nil
+}
+
+// IRR returns the internal rate of return of the cash flows at the equally spaced periods, where
+// the net present value of the values is 0 and the first value is not discounted. It's found by the Newton iteration from guess,
+// the spreadsheet uses 0.1 as the default guess.
+//
+//	sum(values[i] / (1+IRR)^i) = 0
+//
+// It returns ErrInvalidArgument when the values don't have both a positive and a negative value,
+// and a *ConvergenceError when the iteration doesn't converge, try another guess.
+//
+// Example:
+//
+//	values := []decimal.Decimal{"-70000", "12000", "15000", "18000", "21000", "26000"}
+//	opts := financial.Options{Precision: 4, Rounding: decimal.RoundingHalfUp}
+//	financial.IRR(values, decimal.Require("0.1"), opts) // "0.0866"
+func IRR(values []decimal.Decimal, guess decimal.Decimal, opts Options) (decimal.Decimal, error) {
+	if err := checkSigns(len(values), func(i int) decimal.Decimal { return values[i] }); err != nil {
+		return decimal.Zero, err
+	}
+
+	if err := checkDiscountRate(guess); err != nil {
+		return decimal.Zero, err
+	}
+
+	// the root of sum(values[i] * (1+rate)^(n-1-i)), which is the net present value multiplied by (1+rate)^(n-1)
+	return newton("IRR", guess, opts, func(rate decimal.Decimal, places int) (f, df *big.Rat) {
+		x := one.Add(rate)
+		p, dp := decimal.Zero, decimal.Zero
+		for _, v := range values {
+			dp = dp.Mul(x).Add(p).Round(places)
+			p = p.Mul(x).Add(v).Round(places)
+		}
+
+		return p.Rat(), dp.Rat()
+	})
+}
+
+// XNPV returns the net present value of the cash flows on the dates, which are discounted
+// by the actual days from the date of the first flow over 365 days a year like the spreadsheet.
+//
+//	XNPV = sum(flows[i].Amount / (1+rate)^((flows[i].Date - flows[0].Date) / 365))
+//
+// It returns ErrInvalidArgument when a date is before the date of the first flow.
+//
+// Example:
+//
+//	flows := []financial.CashFlow{
+//		{Date: time.Date(2008, 1, 1, 0, 0, 0, 0, time.UTC), Amount: "-10000"},
+//		{Date: time.Date(2008, 3, 1, 0, 0, 0, 0, time.UTC), Amount: "2750"},
+//		{Date: time.Date(2008, 10, 30, 0, 0, 0, 0, time.UTC), Amount: "4250"},
+//		{Date: time.Date(2009, 2, 15, 0, 0, 0, 0, time.UTC), Amount: "3250"},
+//		{Date: time.Date(2009, 4, 1, 0, 0, 0, 0, time.UTC), Amount: "2750"},
+//	}
+//	financial.XNPV(decimal.Require("0.09"), flows, opts) // "2086.65"
+func XNPV(rate decimal.Decimal, flows []CashFlow, opts Options) (decimal.Decimal, error) {
+	if err := checkDiscountRate(rate); err != nil {
+		return decimal.Zero, err
+	}
+
+	if err := checkDates(flows); err != nil {
+		return decimal.Zero, err
+	}
+
+	// the error of each discount factor is multiplied by the amount
+	places := opts.Precision + guardDigits
+	for _, flow := range flows {
+		places = max(places, opts.Precision+guardDigits+intDigits(flow.Amount))
+	}
+
+	sum, _ := xnpv(rate, flows, places)
+	return round(sum, opts), nil
+}
+
+// XIRR returns the internal rate of return of the cash flows on the dates, where XNPV is 0.
+// It's found by the Newton iteration from guess, the spreadsheet uses 0.1 as the default guess.
+//
+// It returns ErrInvalidArgument when the flows don't have both a positive and a negative amount or
+// a date is before the date of the first flow, and a *ConvergenceError when the iteration doesn't converge, try another guess.
+//
+// Example:
+//
+//	opts := financial.Options{Precision: 9, Rounding: decimal.RoundingHalfUp}
+//	financial.XIRR(flows, decimal.Require("0.1"), opts) // "0.373362534", the exact root is 0.3733625335...
+func XIRR(flows []CashFlow, guess decimal.Decimal, opts Options) (decimal.Decimal, error) {
+	if err := checkSigns(len(flows), func(i int) decimal.Decimal { return flows[i].Amount }); err != nil {
+		return decimal.Zero, err
+	}
+
+	if err := checkDates(flows); err != nil {
+		return decimal.Zero, err
+	}
+
+	if err := checkDiscountRate(guess); err != nil {
+		return decimal.Zero, err
+	}
+
+	return newton("XIRR", guess, opts, func(rate decimal.Decimal, places int) (f, df *big.Rat) {
+		return xnpv(rate, flows, places)
+	})
+}
+
+// xnpv returns XNPV and its derivative by rate, the discount factors are rounded to places decimal places.
+//
+//	(1+rate)^(-t) = e^(-t * ln(1+rate))
+//	d/drate XNPV = sum(-t * amount * (1+rate)^(-t-1))
+func xnpv(rate decimal.Decimal, flows []CashFlow, places int) (f, df *big.Rat) {
+	onePlusR := one.Add(rate)
+	lnR, _ := onePlusR.Ln(places)
+
+	sum, dsum := decimal.Zero, decimal.Zero
+	for _, flow := range flows {
+		d := decimal.NewFromInt(days(flows[0].Date, flow.Date))
+		factor := lnR.Mul(d).DivRound(daysPerYear, places).Neg().Exp(places)
+
+		v := flow.Amount.Mul(factor)
+		sum = sum.Add(v)
+		dsum = dsum.Sub(v.Mul(d))
+	}
+
+	df = dsum.Rat()
+	df.Quo(df, daysPerYear.Mul(onePlusR).Rat())
+	return sum.Rat(), df
+}
+
+// days returns the count of the calendar days from the date of from to the date of to, the time of day is ignored.
+func days(from, to time.Time) int64 {
+	y1, m1, d1 := from.Date()
+	y2, m2, d2 := to.Date()
+	diff := time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC).Sub(time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC))
+	return int64(diff / (24 * time.Hour))
+}
+
+// checkSigns checks the n values have both a positive and a negative value, otherwise there is no rate of return.
+func checkSigns(n int, value func(i int) decimal.Decimal) error {
+	positive, negative := false, false
+	for i := range n {
+		switch value(i).Sign() {
+		case 1:
+			positive = true
+		case -1:
+			negative = true
+		}
+	}
+
+	if !positive || !negative {
+		return fmt.Errorf("%w: cash flows need both a positive and a negative value", ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+// checkDates checks no date is before the date of the first flow.
+func checkDates(flows []CashFlow) error {
+	for i := 1; i < len(flows); i++ {
+		if days(flows[0].Date, flows[i].Date) < 0 {
+			return fmt.Errorf("%w: flow %d is before the first flow", ErrInvalidArgument, i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package financial
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yanun0323/decimal"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+var spreadsheetFlows = []CashFlow{
+	{Date: date(2008, 1, 1), Amount: "-10000"},
+	{Date: date(2008, 3, 1), Amount: "2750"},
+	{Date: date(2008, 10, 30), Amount: "4250"},
+	{Date: date(2009, 2, 15), Amount: "3250"},
+	{Date: date(2009, 4, 1), Amount: "2750"},
+}
+
+func (su *FinancialSuite) TestNPV() {
+	testCases := []struct {
+		desc     string
+		rate     string
+		values   []decimal.Decimal
+		expected string
+	}{
+		{"Spreadsheet Example", "0.1", []decimal.Decimal{"-10000", "3000", "4200", "6800"}, "1188.44"},
+		{"Spreadsheet Example 2", "0.08", []decimal.Decimal{"8000", "9200", "10000", "12000", "14500"}, "41922.06"},
+		{"Zero Rate", "0", []decimal.Decimal{"-100", "30", "80"}, "10"},
+		{"Exact", "0.1", []decimal.Decimal{"110", "121"}, "200"},
+		{"Empty", "0.1", nil, "0"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			result, err := NPV(decimal.Require(tc.rate), tc.values, cents)
+			su.Require().NoError(err)
+			su.Equal(tc.expected, result.String(), tc.desc)
+		})
+	}
+
+	_, err := NPV(decimal.Require("-1"), []decimal.Decimal{"1"}, cents)
+	su.ErrorIs(err, ErrInvalidArgument)
+}
+
+func (su *FinancialSuite) TestIRR() {
+	values := []decimal.Decimal{"-70000", "12000", "15000", "18000", "21000", "26000"}
+	testCases := []struct {
+		desc     string
+		values   []decimal.Decimal
+		guess    string
+		expected string
+	}{
+		{"Spreadsheet Example", values, "0.1", "0.08663095"},
+		{"Spreadsheet Example Negative", values[:5], "0.1", "-0.02124485"},
+		{"Spreadsheet Example Guess", values[:3], "-0.1", "-0.44350694"},
+		{"Exact", []decimal.Decimal{"-100", "0", "121"}, "0.5", "0.1"},
+		{"Zero", []decimal.Decimal{"-100", "50", "50"}, "0.1", "0"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			result, err := IRR(tc.values, decimal.Require(tc.guess), exact)
+			su.Require().NoError(err)
+			su.Equal(tc.expected, result.String(), tc.desc)
+
+			// the net present value at IRR is 0
+			npv, err := NPV(result, tc.values, cents)
+			su.Require().NoError(err)
+			su.True(npv.Abs().LessThanOrEqual(decimal.Require("0.01")), "npv %s", npv)
+		})
+	}
+
+	_, err := IRR([]decimal.Decimal{"100", "200"}, decimal.Require("0.1"), exact)
+	su.ErrorIs(err, ErrInvalidArgument)
+}
+
+func (su *FinancialSuite) TestXNPV() {
+	result, err := XNPV(decimal.Require("0.09"), spreadsheetFlows, cents)
+	su.Require().NoError(err)
+	su.Equal("2086.65", result.String())
+
+	// one year is discounted by exactly 1+rate
+	flows := []CashFlow{
+		{Date: date(2023, 1, 1), Amount: "-100"},
+		{Date: date(2024, 1, 1), Amount: "110"},
+	}
+
+	result, err = XNPV(decimal.Require("0.1"), flows, Options{Precision: 20})
+	su.Require().NoError(err)
+	su.Equal("0", result.String())
+
+	flows[1].Date = date(2022, 12, 31)
+	_, err = XNPV(decimal.Require("0.1"), flows, cents)
+	su.ErrorIs(err, ErrInvalidArgument)
+}
+
+func (su *FinancialSuite) TestXIRR() {
+	testCases := []struct {
+		desc      string
+		flows     []CashFlow
+		precision int
+		expected  string
+	}{
+		// the spreadsheet shows 0.373362535 because of its tolerance
+		{"Spreadsheet Example", spreadsheetFlows, 9, "0.373362534"},
+		{"Spreadsheet Example Exact", spreadsheetFlows, 30, "0.373362533518831510308455411915"},
+		{"One Year", []CashFlow{
+			{Date: date(2023, 1, 1), Amount: "-100"},
+			{Date: date(2024, 1, 1), Amount: "110"},
+		}, 10, "0.1"},
+		{"Time Of Day Ignored", []CashFlow{
+			{Date: time.Date(2023, 1, 1, 23, 0, 0, 0, time.UTC), Amount: "-100"},
+			{Date: time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC), Amount: "110"},
+		}, 10, "0.1"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			result, err := XIRR(tc.flows, decimal.Require("0.1"), Options{Precision: tc.precision, Rounding: decimal.RoundingHalfUp})
+			su.Require().NoError(err)
+			su.Equal(tc.expected, result.String(), tc.desc)
+		})
+	}
+
+	_, err := XIRR(spreadsheetFlows[1:], decimal.Require("0.1"), exact)
+	su.ErrorIs(err, ErrInvalidArgument)
+}
+
+func (su *FinancialSuite) TestConvergenceError() {
+	values := []decimal.Decimal{"-70000", "12000", "15000", "18000", "21000", "26000"}
+
+	_, err := IRR(values, decimal.Require("0.1"), Options{Precision: 8, MaxIterations: 2})
+	su.ErrorIs(err, ErrNoConvergence)
+
+	var convergenceErr *ConvergenceError
+	su.Require().True(errors.As(err, &convergenceErr))
+	su.Equal("IRR", convergenceErr.Func)
+	su.Equal(2, convergenceErr.Iterations)
+	su.Equal("0.1", convergenceErr.Guess.String())
+	su.True(convergenceErr.Last.Sub(decimal.Require("0.0866")).Abs().LessThan(decimal.Require("0.0001")), convergenceErr.Last)
+
+	// a looser tolerance converges in fewer iterations
+	result, err := IRR(values, decimal.Require("0.1"), Options{Precision: 4, Tolerance: decimal.Require("0.001"), MaxIterations: 3})
+	su.Require().NoError(err)
+	su.Equal("0.0866", result.String())
+
+	_, err = XIRR(spreadsheetFlows, decimal.Require("0.1"), Options{Precision: 9, MaxIterations: 1})
+	su.True(errors.As(err, &convergenceErr))
+	su.Equal("XIRR", convergenceErr.Func)
+}
@@ -0,0 +1,139 @@
+package financial
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/yanun0323/decimal"
+)
+
+// CompoundAmount returns the principal with the interest compounded periodsPerYear times a year for years,
+// which may have a fraction.
+//
+//	principal * (1 + rate/periodsPerYear)^(periodsPerYear*years)
+//
+// Example:
+//
+//	opts := financial.Options{Precision: 2, Rounding: decimal.RoundingHalfUp}
+//	financial.CompoundAmount(decimal.Require("1000"), decimal.Require("0.05"), 12, decimal.Require("10"), opts) // "1647.01"
+//	financial.CompoundAmount(decimal.Require("1000"), decimal.Require("0.05"), 1, decimal.Require("2.5"), opts) // "1129.73"
+func CompoundAmount(principal, rate decimal.Decimal, periodsPerYear int, years decimal.Decimal, opts Options) (decimal.Decimal, error) {
+	g, err := growth(rate, periodsPerYear, years, opts.Precision+guardDigits+intDigits(principal))
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return round(g.Mul(g, principal.Rat()), opts), nil
+}
+
+// CompoundInterest returns the interest of the principal compounded periodsPerYear times a year for years,
+// which is CompoundAmount minus the principal.
+//
+// Example:
+//
+//	financial.CompoundInterest(decimal.Require("1000"), decimal.Require("0.05"), 12, decimal.Require("10"), opts) // "647.01"
+func CompoundInterest(principal, rate decimal.Decimal, periodsPerYear int, years decimal.Decimal, opts Options) (decimal.Decimal, error) {
+	g, err := growth(rate, periodsPerYear, years, opts.Precision+guardDigits+intDigits(principal))
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	g.Sub(g, big.NewRat(1, 1))
+	return round(g.Mul(g, principal.Rat()), opts), nil
+}
+
+// ContinuousAmount returns the principal with the interest compounded continuously for years.
+//
+//	principal * e^(rate*years)
+//
+// Example:
+//
+//	financial.ContinuousAmount(decimal.Require("1000"), decimal.Require("0.05"), decimal.Require("10"), opts) // "1648.72"
+func ContinuousAmount(principal, rate, years decimal.Decimal, opts Options) decimal.Decimal {
+	g := rate.Mul(years).Exp(opts.Precision + guardDigits + intDigits(principal)).Rat()
+	return round(g.Mul(g, principal.Rat()), opts)
+}
+
+// ContinuousInterest returns the interest of the principal compounded continuously for years,
+// which is ContinuousAmount minus the principal.
+//
+// Example:
+//
+//	financial.ContinuousInterest(decimal.Require("1000"), decimal.Require("0.05"), decimal.Require("10"), opts) // "648.72"
+func ContinuousInterest(principal, rate, years decimal.Decimal, opts Options) decimal.Decimal {
+	g := rate.Mul(years).Exp(opts.Precision + guardDigits + intDigits(principal)).Rat()
+	g.Sub(g, big.NewRat(1, 1))
+	return round(g.Mul(g, principal.Rat()), opts)
+}
+
+// EffectiveRate returns the effective annual rate of the nominal annual rate compounded periodsPerYear times a year,
+// the same as the spreadsheet EFFECT.
+//
+//	(1 + nominal/periodsPerYear)^periodsPerYear - 1
+//
+// Example:
+//
+//	opts := financial.Options{Precision: 8, Rounding: decimal.RoundingHalfUp}
+//	financial.EffectiveRate(decimal.Require("0.0525"), 4, opts) // "0.05354267"
+func EffectiveRate(nominal decimal.Decimal, periodsPerYear int, opts Options) (decimal.Decimal, error) {
+	g, err := growth(nominal, periodsPerYear, decimal.Require("1"), opts.Precision+guardDigits)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return round(g.Sub(g, big.NewRat(1, 1)), opts), nil
+}
+
+// NominalRate returns the nominal annual rate compounded periodsPerYear times a year of the effective annual rate,
+// the same as the spreadsheet NOMINAL.
+//
+//	periodsPerYear * ((1 + effective)^(1/periodsPerYear) - 1)
+//
+// Example:
+//
+//	opts := financial.Options{Precision: 8, Rounding: decimal.RoundingHalfUp}
+//	financial.NominalRate(decimal.Require("0.053543"), 4, opts) // "0.05250032"
+func NominalRate(effective decimal.Decimal, periodsPerYear int, opts Options) (decimal.Decimal, error) {
+	if periodsPerYear <= 0 {
+		return decimal.Zero, fmt.Errorf("%w: periods per year %d", ErrInvalidArgument, periodsPerYear)
+	}
+
+	if err := checkDiscountRate(effective); err != nil {
+		return decimal.Zero, err
+	}
+
+	// (1 + effective)^(1/n) = e^(ln(1 + effective) / n), the error is multiplied by n
+	n := decimal.NewFromInt(int64(periodsPerYear))
+	wp := opts.Precision + guardDigits + intDigits(n)
+	ln, _ := one.Add(effective).Ln(wp)
+	g := ln.DivRound(n, wp).Exp(wp)
+
+	return round(g.Sub(one).Mul(n).Rat(), opts), nil
+}
+
+// growth returns (1 + rate/periodsPerYear)^(periodsPerYear*years) rounded to places decimal places.
+func growth(rate decimal.Decimal, periodsPerYear int, years decimal.Decimal, places int) (*big.Rat, error) {
+	if periodsPerYear <= 0 {
+		return nil, fmt.Errorf("%w: periods per year %d", ErrInvalidArgument, periodsPerYear)
+	}
+
+	n := decimal.NewFromInt(int64(periodsPerYear))
+	base := new(big.Rat).Quo(rate.Rat(), n.Rat())
+	base.Add(base, big.NewRat(1, 1))
+	if base.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: rate %s", ErrInvalidArgument, rate)
+	}
+
+	// the relative error of the rounded base is multiplied by the exponent, and the error by the power
+	exponent := years.Mul(n)
+	f, _ := base.Float64()
+	powerDigits := max(0, int(math.Ceil(exponent.InexactFloat64()*math.Log10(f))))
+	b := decimal.NewFromRat(base, places+intDigits(exponent)+powerDigits, decimal.RoundingHalfEven)
+	g, err := b.PowWithPrecision(exponent, places)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.Rat(), nil
+}
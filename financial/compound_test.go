@@ -0,0 +1,115 @@
+package financial
+
+import (
+	"testing"
+
+	"github.com/yanun0323/decimal"
+)
+
+func (su *FinancialSuite) TestCompoundAmount() {
+	testCases := []struct {
+		desc            string
+		principal, rate string
+		periodsPerYear  int
+		years           string
+		opts            Options
+		amount          string
+		interest        string
+	}{
+		{"Monthly", "1000", "0.05", 12, "10", cents, "1647.01", "647.01"},
+		{"Annually", "1000", "0.1", 1, "2", cents, "1210", "210"},
+		{"Fractional Years", "1000", "0.05", 1, "2.5", cents, "1129.73", "129.73"},
+		{"Fractional Periods", "1000000", "0.05", 365, "10.3", Options{Precision: 20}, "1673579.47235073337891688676", "673579.47235073337891688676"},
+		{"Negative Years", "1000", "0.05", 12, "-1", Options{Precision: 20}, "951.32824164875747483414", "-48.67175835124252516586"},
+		{"Zero Years", "1000", "0.05", 4, "0", cents, "1000", "0"},
+		{"Hourly", "1000", "0.05", 8760, "30", cents, "4481.67", "3481.67"},
+		{"Hourly Precise", "1000", "0.05", 8760, "30", Options{Precision: 20}, "4481.66988513933096138779", "3481.66988513933096138779"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			amount, err := CompoundAmount(decimal.Require(tc.principal), decimal.Require(tc.rate), tc.periodsPerYear, decimal.Require(tc.years), tc.opts)
+			su.Require().NoError(err)
+			su.Equal(tc.amount, amount.String(), tc.desc)
+
+			interest, err := CompoundInterest(decimal.Require(tc.principal), decimal.Require(tc.rate), tc.periodsPerYear, decimal.Require(tc.years), tc.opts)
+			su.Require().NoError(err)
+			su.Equal(tc.interest, interest.String(), tc.desc)
+		})
+	}
+
+	_, err := CompoundAmount(decimal.Require("1000"), decimal.Require("0.05"), 0, decimal.Require("1"), cents)
+	su.ErrorIs(err, ErrInvalidArgument)
+
+	_, err = CompoundInterest(decimal.Require("1000"), decimal.Require("-12"), 12, decimal.Require("1"), cents)
+	su.ErrorIs(err, ErrInvalidArgument)
+}
+
+func (su *FinancialSuite) TestContinuousAmount() {
+	principal, rate := decimal.Require("1000"), decimal.Require("0.05")
+
+	su.Equal("1648.72", ContinuousAmount(principal, rate, decimal.Require("10"), cents).String())
+	su.Equal("648.72", ContinuousInterest(principal, rate, decimal.Require("10"), cents).String())
+	su.Equal("1648.72127070012814684865", ContinuousAmount(principal, rate, decimal.Require("10"), Options{Precision: 20}).String())
+	su.Equal("1000", ContinuousAmount(principal, rate, decimal.Zero, cents).String())
+
+	// the continuous compounding is the limit of the periodic compounding
+	daily, err := CompoundAmount(principal, rate, 365, decimal.Require("10"), cents)
+	su.Require().NoError(err)
+	su.True(daily.LessThan(ContinuousAmount(principal, rate, decimal.Require("10"), cents)))
+}
+
+func (su *FinancialSuite) TestEffectiveNominalRate() {
+	testCases := []struct {
+		nominal        string
+		periodsPerYear int
+		effective      string
+	}{
+		{"0.0525", 4, "0.05354267"},
+		{"0.06", 12, "0.06167781"},
+		{"0.1", 1, "0.1"},
+		{"0.12", 365, "0.12747462"},
+	}
+
+	for _, tc := range testCases {
+		effective, err := EffectiveRate(decimal.Require(tc.nominal), tc.periodsPerYear, exact)
+		su.Require().NoError(err)
+		su.Equal(tc.effective, effective.String(), "effective of %s", tc.nominal)
+
+		// the round trip with the exact effective rate
+		exactEffective, err := EffectiveRate(decimal.Require(tc.nominal), tc.periodsPerYear, Options{Precision: 40})
+		su.Require().NoError(err)
+
+		nominal, err := NominalRate(exactEffective, tc.periodsPerYear, exact)
+		su.Require().NoError(err)
+		su.Equal(tc.nominal, nominal.String(), "nominal of %s", exactEffective)
+	}
+
+	// the spreadsheet example of NOMINAL
+	nominal, err := NominalRate(decimal.Require("0.053543"), 4, exact)
+	su.Require().NoError(err)
+	su.Equal("0.05250032", nominal.String())
+
+	_, err = EffectiveRate(decimal.Require("0.05"), 0, exact)
+	su.ErrorIs(err, ErrInvalidArgument)
+
+	_, err = NominalRate(decimal.Require("-1"), 12, exact)
+	su.ErrorIs(err, ErrInvalidArgument)
+
+	_, err = NominalRate(decimal.Require("0.05"), -1, exact)
+	su.ErrorIs(err, ErrInvalidArgument)
+}
+
+func (su *FinancialSuite) TestCompoundPrecision() {
+	// the rounding mode applies to the result only
+	floor := Options{Precision: 2, Rounding: decimal.RoundingFloor}
+	amount, err := CompoundAmount(decimal.Require("1000"), decimal.Require("0.05"), 12, decimal.Require("10"), floor)
+	su.Require().NoError(err)
+	su.Equal("1647", amount.String())
+
+	su.T().Run("Large Principal", func(t *testing.T) {
+		amount, err := CompoundAmount(decimal.Require("123456789012345678"), decimal.Require("0.03"), 1, decimal.Require("0.5"), cents)
+		su.Require().NoError(err)
+		su.Equal("125294956466076485.18", amount.String())
+	})
+}
@@ -0,0 +1,350 @@
+// Package financial provides the time-value-of-money functions on decimal.Decimal
+// with the sign and timing conventions of the spreadsheet functions of the same names.
+//
+// The cash paid out is negative and the cash received is positive, e.g. a loan of 200000 is pv = 200000
+// and its monthly payment is negative. The intermediate values are exact or carry the guard digits,
+// and only the result is rounded to the precision of Options with its rounding mode.
+//
+// Example:
+//
+//	opts := financial.Options{Precision: 2, Rounding: decimal.RoundingHalfUp}
+//	pmt, err := financial.PMT(decimal.Require("0.005"), 360, decimal.Require("200000"), decimal.Zero, financial.End, opts)
+//	pmt.String() // "-1199.1"
+package financial
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/yanun0323/decimal"
+)
+
+const (
+	// guardDigits is the count of the extra digits of the intermediate results which can't be exact, like logarithms.
+	guardDigits = 10
+
+	// defaultMaxIterations is the max count of the Newton iterations when Options.MaxIterations is not set.
+	defaultMaxIterations = 100
+)
+
+var (
+	// ErrInvalidArgument is returned when the arguments have no meaningful result, e.g. a negative nper.
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrNoConvergence is matched by the *ConvergenceError with errors.Is.
+	ErrNoConvergence = errors.New("no convergence")
+
+	one = decimal.Require("1")
+)
+
+// Timing is when the payments are due in each period.
+type Timing int
+
+const (
+	// End means the payments are due at the end of each period, the type 0 of the spreadsheet functions.
+	End Timing = iota
+	// Begin means the payments are due at the beginning of each period, the type 1 of the spreadsheet functions.
+	Begin
+)
+
+// Options is how the result is rounded, and how RATE, IRR and XIRR iterate.
+type Options struct {
+	// Precision is the count of the decimal places of the result.
+	Precision int
+	// Rounding is the rounding mode of the result.
+	Rounding decimal.RoundingMode
+	// Tolerance is the max change of the estimate in the last iteration, 10^-(Precision+5) when it's zero.
+	Tolerance decimal.Decimal
+	// MaxIterations is the max count of the iterations, 100 when it's not positive.
+	MaxIterations int
+}
+
+// FV returns the future value of an investment with the periodic constant payments and the constant interest rate.
+//
+//	FV = -(pv * (1+rate)^nper + pmt * (1+rate*type) * ((1+rate)^nper - 1) / rate)
+//
+// Example:
+//
+//	// the payments of 200 at the beginning of 10 months with 6% annual interest, after the deposit of 500
+//	financial.FV(decimal.Require("0.005"), 10, decimal.Require("-200"), decimal.Require("-500"), financial.Begin, opts) // "2581.4"
+func FV(rate decimal.Decimal, nper int, pmt, pv decimal.Decimal, when Timing, opts Options) (decimal.Decimal, error) {
+	if nper < 0 {
+		return decimal.Zero, fmt.Errorf("%w: nper %d", ErrInvalidArgument, nper)
+	}
+
+	g, a, err := annuity(rate, nper, when, opts.Precision, pmt, pv)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	// -(pv * g + pmt * a)
+	result := new(big.Rat).Mul(pv.Rat(), g)
+	result.Add(result, a.Mul(pmt.Rat(), a))
+	return round(result.Neg(result), opts), nil
+}
+
+// PV returns the present value of an investment with the periodic constant payments and the constant interest rate.
+//
+//	PV = -(fv + pmt * (1+rate*type) * ((1+rate)^nper - 1) / rate) / (1+rate)^nper
+//
+// Example:
+//
+//	// the annuity paying 500 at the end of each month for 20 years with 8% annual interest
+//	financial.PV(decimal.Require("0.08").Div(decimal.Require("12")), 240, decimal.Require("500"), decimal.Zero, financial.End, opts) // "-59777.15"
+func PV(rate decimal.Decimal, nper int, pmt, fv decimal.Decimal, when Timing, opts Options) (decimal.Decimal, error) {
+	if nper < 0 {
+		return decimal.Zero, fmt.Errorf("%w: nper %d", ErrInvalidArgument, nper)
+	}
+
+	g, a, err := annuity(rate, nper, when, opts.Precision, pmt, fv)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	// -(fv + pmt * a) / g
+	result := new(big.Rat).Add(fv.Rat(), a.Mul(pmt.Rat(), a))
+	result.Quo(result, g)
+	return round(result.Neg(result), opts), nil
+}
+
+// PMT returns the periodic constant payment of a loan or an investment with the constant interest rate.
+//
+//	PMT = -(fv + pv * (1+rate)^nper) * rate / ((1+rate*type) * ((1+rate)^nper - 1))
+//
+// Example:
+//
+//	// the loan of 200000 for 30 years with 6% annual interest
+//	financial.PMT(decimal.Require("0.005"), 360, decimal.Require("200000"), decimal.Zero, financial.End, opts) // "-1199.1"
+func PMT(rate decimal.Decimal, nper int, pv, fv decimal.Decimal, when Timing, opts Options) (decimal.Decimal, error) {
+	if nper <= 0 {
+		return decimal.Zero, fmt.Errorf("%w: nper %d", ErrInvalidArgument, nper)
+	}
+
+	g, a, err := annuity(rate, nper, when, opts.Precision, pv, fv)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	// -(fv + pv * g) / a
+	result := new(big.Rat).Mul(pv.Rat(), g)
+	result.Add(result, fv.Rat())
+	result.Quo(result, a)
+	return round(result.Neg(result), opts), nil
+}
+
+// NPER returns the count of the periods of an investment with the periodic constant payments and the constant interest rate,
+// which may have a fraction.
+//
+//	NPER = ln((pmt * (1+rate*type) - fv * rate) / (pmt * (1+rate*type) + pv * rate)) / ln(1+rate)
+//
+// It returns ErrInvalidArgument when the payments can never reach fv.
+//
+// Example:
+//
+//	// how many payments of 100 at the beginning of each month with 12% annual interest to reach 10000 from a loan of 1000
+//	financial.NPER(decimal.Require("0.01"), decimal.Require("-100"), decimal.Require("-1000"), decimal.Require("10000"), financial.Begin, opts) // "59.67"
+func NPER(rate, pmt, pv, fv decimal.Decimal, when Timing, opts Options) (decimal.Decimal, error) {
+	if err := checkRate(rate, when); err != nil {
+		return decimal.Zero, err
+	}
+
+	if rate.IsZero() {
+		if pmt.IsZero() {
+			return decimal.Zero, fmt.Errorf("%w: pmt 0 with rate 0", ErrInvalidArgument)
+		}
+
+		// -(pv + fv) / pmt
+		result := new(big.Rat).Quo(pv.Add(fv).Rat(), pmt.Rat())
+		return round(result.Neg(result), opts), nil
+	}
+
+	// the numerator and the denominator of the argument of ln are exact
+	p := pmt
+	if when == Begin {
+		p = pmt.Mul(one.Add(rate))
+	}
+
+	num, den := p.Sub(fv.Mul(rate)), p.Add(pv.Mul(rate))
+	if num.Sign()*den.Sign() <= 0 {
+		return decimal.Zero, fmt.Errorf("%w: no solution for nper", ErrInvalidArgument)
+	}
+
+	// dividing by ln(1+rate) magnifies the error of the logarithms when rate is small
+	wp := opts.Precision + guardDigits + max(0, int(-rate.Exponent()))
+	lnNum, _ := num.Abs().Ln(wp)
+	lnDen, _ := den.Abs().Ln(wp)
+	lnRate, _ := one.Add(rate).Ln(wp)
+
+	result := new(big.Rat).Quo(lnNum.Sub(lnDen).Rat(), lnRate.Rat())
+	return round(result, opts), nil
+}
+
+// RATE returns the interest rate per period of an annuity, which is found by the Newton iteration from guess.
+// The spreadsheet uses 0.1 as the default guess.
+//
+// It returns a *ConvergenceError when the iteration doesn't converge, try another guess.
+//
+// Example:
+//
+//	// the rate of a loan of 8000 with 48 monthly payments of 200
+//	opts := financial.Options{Precision: 8, Rounding: decimal.RoundingHalfUp}
+//	financial.RATE(48, decimal.Require("-200"), decimal.Require("8000"), decimal.Zero, financial.End, decimal.Require("0.1"), opts) // "0.00770147"
+func RATE(nper int, pmt, pv, fv decimal.Decimal, when Timing, guess decimal.Decimal, opts Options) (decimal.Decimal, error) {
+	if nper <= 0 {
+		return decimal.Zero, fmt.Errorf("%w: nper %d", ErrInvalidArgument, nper)
+	}
+
+	if err := checkRate(guess, when); err != nil {
+		return decimal.Zero, err
+	}
+
+	n := big.NewRat(int64(nper), 1)
+	pvRat, pmtRat, fvRat := pv.Rat(), pmt.Rat(), fv.Rat()
+	return newton("RATE", guess, opts, func(rate decimal.Decimal, places int) (f, df *big.Rat) {
+		return rateFunc(rate, nper, n, pmtRat, pvRat, fvRat, when, places)
+	})
+}
+
+// rateFunc returns f(rate) and f'(rate), where
+//
+//	f(rate) = pv * (1+rate)^nper + pmt * (1+rate*type) * ((1+rate)^nper - 1) / rate + fv
+//
+// (1+rate)^nper is rounded to places decimal places by PowWithPrecision, its exact value has nper times the digits of rate.
+func rateFunc(rate decimal.Decimal, nper int, n, pmt, pv, fv *big.Rat, when Timing, places int) (f, df *big.Rat) {
+	if rate.IsZero() {
+		// the limits at 0
+		//	f(0)  = pv + pmt * nper + fv
+		//	f'(0) = pv * nper + pmt * (nper * (nper-1) / 2 + type * nper)
+		f = new(big.Rat).Mul(pmt, n)
+		f.Add(f, pv)
+		f.Add(f, fv)
+
+		k := big.NewRat(int64(nper)*int64(nper-1), 2)
+		if when == Begin {
+			k.Add(k, n)
+		}
+
+		df = new(big.Rat).Mul(pv, n)
+		df.Add(df, k.Mul(k, pmt))
+		return f, df
+	}
+
+	r := rate.Rat()
+	onePlusR := one.Add(rate)
+	power, _ := onePlusR.PowWithPrecision(decimal.NewFromInt(int64(nper)), places)
+	g := power.Rat()
+	gm1 := new(big.Rat).Sub(g, big.NewRat(1, 1))
+
+	// s = ((1+rate)^nper - 1) / rate, t = 1 + rate*type
+	s := new(big.Rat).Quo(gm1, r)
+	t := big.NewRat(1, 1)
+	if when == Begin {
+		t = onePlusR.Rat()
+	}
+
+	f = new(big.Rat).Mul(pv, g)
+	f.Add(f, new(big.Rat).Mul(pmt, new(big.Rat).Mul(t, s)))
+	f.Add(f, fv)
+
+	// dg = nper * (1+rate)^(nper-1), ds = (dg - s) / rate
+	dg := new(big.Rat).Mul(n, g)
+	dg.Quo(dg, onePlusR.Rat())
+	ds := new(big.Rat).Sub(dg, s)
+	ds.Quo(ds, r)
+
+	// f' = pv * dg + pmt * (t * ds + type * s)
+	dt := new(big.Rat).Mul(t, ds)
+	if when == Begin {
+		dt.Add(dt, s)
+	}
+
+	df = new(big.Rat).Mul(pv, dg)
+	df.Add(df, dt.Mul(dt, pmt))
+	return f, df
+}
+
+// annuity returns g = (1+rate)^nper and a = (1+rate*type) * ((1+rate)^nper - 1) / rate, a is nper when rate is 0.
+//
+// The exact g has nper times the digits of rate, so it's rounded to keep the significant digits of g and g-1 which
+// make the terms of the values, multiplied or divided by g and a, exact to precision decimal places.
+func annuity(rate decimal.Decimal, nper int, when Timing, precision int, values ...decimal.Decimal) (g, a *big.Rat, err error) {
+	if err := checkRate(rate, when); err != nil {
+		return nil, nil, err
+	}
+
+	if rate.IsZero() {
+		return big.NewRat(1, 1), big.NewRat(int64(nper), 1), nil
+	}
+
+	// the magnitudes of rate, g, g-1 and a in log10
+	rf := rate.InexactFloat64()
+	lr := math.Log10(math.Abs(rf))
+	if rf == 0 {
+		// too small for float64
+		lr = float64(rate.Exponent())
+	}
+
+	x := float64(nper) * math.Log1p(rf)
+	lg, lgm1 := x/math.Ln10, x/math.Ln10
+	switch {
+	case x == 0:
+		lgm1 = math.Log10(float64(nper)) + lr
+	case x < 30:
+		lgm1 = math.Log10(math.Abs(math.Expm1(x)))
+	}
+	la := lgm1 - lr
+
+	valueDigits := 0
+	for _, v := range values {
+		valueDigits = max(valueDigits, intDigits(v))
+	}
+
+	// the significant digits, and the places to keep them in the smaller one of g and g-1
+	onePlusR := one.Add(rate)
+	digits := precision + guardDigits + valueDigits + intDigits(onePlusR) + int(math.Ceil(math.Abs(lg)+math.Abs(la)))
+	places := digits - int(math.Floor(min(lg, lgm1)))
+
+	power, err := onePlusR.PowWithPrecision(decimal.NewFromInt(int64(nper)), places)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	g = power.Rat()
+
+	a = new(big.Rat).Sub(g, big.NewRat(1, 1))
+	a.Quo(a, rate.Rat())
+	if when == Begin {
+		a.Mul(a, onePlusR.Rat())
+	}
+
+	return g, a, nil
+}
+
+// checkRate checks the rate is greater than -1 and the timing is valid.
+func checkRate(rate decimal.Decimal, when Timing) error {
+	if when != End && when != Begin {
+		return fmt.Errorf("%w: timing %d", ErrInvalidArgument, when)
+	}
+
+	return checkDiscountRate(rate)
+}
+
+// checkDiscountRate checks the rate is greater than -1, otherwise the discount factor 1/(1+rate) is undefined.
+func checkDiscountRate(rate decimal.Decimal) error {
+	if rate.Cmp(one.Neg()) <= 0 {
+		return fmt.Errorf("%w: rate %s", ErrInvalidArgument, rate)
+	}
+
+	return nil
+}
+
+// intDigits returns the count of the digits of the integer part of d.
+func intDigits(d decimal.Decimal) int {
+	return len(d.Abs().Truncate(0).String())
+}
+
+// round rounds r to the precision with the rounding mode of opts.
+func round(r *big.Rat, opts Options) decimal.Decimal {
+	return decimal.NewFromRat(r, opts.Precision, opts.Rounding)
+}
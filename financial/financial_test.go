@@ -0,0 +1,230 @@
+package financial
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/yanun0323/decimal"
+)
+
+func TestFinancial(t *testing.T) {
+	suite.Run(t, new(FinancialSuite))
+}
+
+type FinancialSuite struct {
+	suite.Suite
+}
+
+var (
+	cents = Options{Precision: 2, Rounding: decimal.RoundingHalfUp}
+	exact = Options{Precision: 8, Rounding: decimal.RoundingHalfUp}
+)
+
+func (su *FinancialSuite) TestFV() {
+	testCases := []struct {
+		desc     string
+		rate     string
+		nper     int
+		pmt, pv  string
+		when     Timing
+		opts     Options
+		expected string
+	}{
+		{"Spreadsheet Example", "0.005", 10, "-200", "-500", Begin, cents, "2581.4"},
+		{"End", "0.005", 10, "-200", "-500", End, cents, "2571.18"},
+		{"Lump Sum", "0.1", 2, "0", "-100", End, cents, "121"},
+		{"Zero Rate", "0", 12, "-100", "-1000", Begin, cents, "2200"},
+		{"Zero Periods", "0.05", 0, "-100", "-1000", End, cents, "1000"},
+		{"Floor", "0.005", 10, "-200", "-500", Begin, Options{Precision: 0, Rounding: decimal.RoundingFloor}, "2581"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			result, err := FV(decimal.Require(tc.rate), tc.nper, decimal.Require(tc.pmt), decimal.Require(tc.pv), tc.when, tc.opts)
+			su.Require().NoError(err)
+			su.Equal(tc.expected, result.String(), tc.desc)
+		})
+	}
+}
+
+func (su *FinancialSuite) TestPV() {
+	testCases := []struct {
+		desc     string
+		rate     string
+		nper     int
+		pmt, fv  string
+		when     Timing
+		expected string
+	}{
+		{"Spreadsheet Example", "0.00666666666666666667", 240, "500", "0", End, "-59777.15"},
+		{"Begin", "0.00666666666666666667", 240, "500", "0", Begin, "-60175.66"},
+		{"Future Value", "0.1", 2, "0", "121", End, "-100"},
+		{"Zero Rate", "0", 12, "100", "1000", End, "-2200"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			result, err := PV(decimal.Require(tc.rate), tc.nper, decimal.Require(tc.pmt), decimal.Require(tc.fv), tc.when, cents)
+			su.Require().NoError(err)
+			su.Equal(tc.expected, result.String(), tc.desc)
+		})
+	}
+}
+
+func (su *FinancialSuite) TestPMT() {
+	testCases := []struct {
+		desc     string
+		rate     string
+		nper     int
+		pv, fv   string
+		when     Timing
+		expected string
+	}{
+		{"Mortgage", "0.005", 360, "200000", "0", End, "-1199.1"},
+		{"Spreadsheet Example", "0.00666666666666666667", 10, "10000", "0", End, "-1037.03"},
+		{"Spreadsheet Example Begin", "0.00666666666666666667", 10, "10000", "0", Begin, "-1030.16"},
+		{"Saving", "0.005", 216, "0", "50000", End, "-129.08"},
+		{"Zero Rate", "0", 10, "1000", "0", Begin, "-100"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			result, err := PMT(decimal.Require(tc.rate), tc.nper, decimal.Require(tc.pv), decimal.Require(tc.fv), tc.when, cents)
+			su.Require().NoError(err)
+			su.Equal(tc.expected, result.String(), tc.desc)
+		})
+	}
+}
+
+func (su *FinancialSuite) TestNPER() {
+	testCases := []struct {
+		desc        string
+		rate        string
+		pmt, pv, fv string
+		when        Timing
+		expected    string
+	}{
+		{"Spreadsheet Example", "0.01", "-100", "-1000", "10000", Begin, "59.67386567"},
+		{"Spreadsheet Example End", "0.01", "-100", "-1000", "10000", End, "60.08212285"},
+		{"Spreadsheet Example No Future Value", "0.01", "-100", "-1000", "0", End, "-9.57859404"},
+		{"Small Rate", "0.0001", "-100", "10000", "0", End, "100.50838362"},
+		{"Zero Rate", "0", "-100", "1000", "0", End, "10"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			result, err := NPER(decimal.Require(tc.rate), decimal.Require(tc.pmt), decimal.Require(tc.pv), decimal.Require(tc.fv), tc.when, exact)
+			su.Require().NoError(err)
+			su.Equal(tc.expected, result.String(), tc.desc)
+		})
+	}
+
+	// the payment is less than the interest
+	_, err := NPER(decimal.Require("0.01"), decimal.Require("-5"), decimal.Require("1000"), decimal.Zero, End, exact)
+	su.ErrorIs(err, ErrInvalidArgument)
+
+	_, err = NPER(decimal.Zero, decimal.Zero, decimal.Require("1000"), decimal.Zero, End, exact)
+	su.ErrorIs(err, ErrInvalidArgument)
+}
+
+func (su *FinancialSuite) TestRATE() {
+	testCases := []struct {
+		desc        string
+		nper        int
+		pmt, pv, fv string
+		when        Timing
+		guess       string
+		expected    string
+	}{
+		{"Spreadsheet Example", 48, "-200", "8000", "0", End, "0.1", "0.00770147"},
+		{"Begin", 48, "-200", "8000", "0", Begin, "0.1", "0.00805298"},
+		{"Zero Guess", 48, "-200", "8000", "0", End, "0", "0.00770147"},
+		{"Zero Rate", 10, "-100", "1000", "0", End, "0.1", "0"},
+		{"Lump Sum", 2, "0", "-100", "121", End, "0.1", "0.1"},
+		{"Mortgage", 360, "-1199.1", "200000", "0", End, "0.01", "0.00499999"},
+	}
+
+	for _, tc := range testCases {
+		su.T().Run(tc.desc, func(t *testing.T) {
+			result, err := RATE(tc.nper, decimal.Require(tc.pmt), decimal.Require(tc.pv), decimal.Require(tc.fv), tc.when, decimal.Require(tc.guess), exact)
+			su.Require().NoError(err)
+			su.Equal(tc.expected, result.String(), tc.desc)
+		})
+	}
+
+	// the payments never pay back the loan
+	_, err := RATE(10, decimal.Require("100"), decimal.Require("1000"), decimal.Zero, End, decimal.Require("0.1"), exact)
+	su.True(errors.Is(err, ErrNoConvergence), err)
+}
+
+func (su *FinancialSuite) TestRoundTrip() {
+	rate, pv := decimal.Require("0.0041666"), decimal.Require("350000")
+	opts := Options{Precision: 20, Rounding: decimal.RoundingHalfEven}
+
+	pmt, err := PMT(rate, 300, pv, decimal.Zero, Begin, opts)
+	su.Require().NoError(err)
+
+	fv, err := FV(rate, 300, pmt, pv, Begin, opts)
+	su.Require().NoError(err)
+	su.True(fv.Abs().LessThanOrEqual(decimal.Require("0.000000000001")), fv)
+
+	nper, err := NPER(rate, pmt, pv, decimal.Zero, Begin, Options{Precision: 10, Rounding: decimal.RoundingHalfEven})
+	su.Require().NoError(err)
+	su.Equal("300", nper.String())
+
+	result, err := RATE(300, pmt, pv, decimal.Zero, Begin, decimal.Require("0.1"), Options{Precision: 10, Rounding: decimal.RoundingHalfEven})
+	su.Require().NoError(err)
+	su.Equal("0.0041666", result.String())
+}
+
+func (su *FinancialSuite) TestLongTerm() {
+	// 30 years of weekly periods, the powers are rounded to the precision instead of carrying every digit
+	rate := decimal.Require("0.05").DivRound(decimal.Require("52"), 30)
+	start := time.Now()
+
+	fv, err := FV(rate, 1560, decimal.Require("-100"), decimal.Require("-1000"), End, cents)
+	su.Require().NoError(err)
+	su.Equal("366238.33", fv.String())
+
+	pv, err := PV(rate, 1560, decimal.Require("-100"), decimal.Zero, Begin, cents)
+	su.Require().NoError(err)
+	su.Equal("80855.4", pv.String())
+
+	pv, err = PV(rate, 1560, decimal.Require("-100"), decimal.Zero, Begin, Options{Precision: 20, Rounding: decimal.RoundingHalfUp})
+	su.Require().NoError(err)
+	su.Equal("80855.40417172379316406421", pv.String())
+
+	pmt, err := PMT(rate, 1560, decimal.Require("250000"), decimal.Zero, End, cents)
+	su.Require().NoError(err)
+	su.Equal("-309.49", pmt.String())
+
+	result, err := RATE(1560, decimal.Require("-200"), decimal.Require("250000"), decimal.Zero, End, rate, Options{Precision: 10, Rounding: decimal.RoundingHalfUp})
+	su.Require().NoError(err)
+	su.Equal("0.0002951872", result.String())
+
+	su.Less(time.Since(start), time.Second)
+}
+
+func (su *FinancialSuite) TestInvalidArgument() {
+	one, zero := decimal.Require("1"), decimal.Zero
+
+	_, err := FV(one.Neg(), 10, one, one, End, cents)
+	su.ErrorIs(err, ErrInvalidArgument)
+
+	_, err = PV(one, -1, one, one, End, cents)
+	su.ErrorIs(err, ErrInvalidArgument)
+
+	_, err = PMT(one, 0, one, one, End, cents)
+	su.ErrorIs(err, ErrInvalidArgument)
+
+	_, err = PMT(one, 10, one, one, Timing(2), cents)
+	su.ErrorIs(err, ErrInvalidArgument)
+
+	_, err = RATE(0, one, one, zero, End, one, cents)
+	su.ErrorIs(err, ErrInvalidArgument)
+
+	_, err = NPER(decimal.Require("-2"), one, one, zero, End, cents)
+	su.ErrorIs(err, ErrInvalidArgument)
+}
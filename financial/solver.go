@@ -0,0 +1,82 @@
+package financial
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/yanun0323/decimal"
+)
+
+// ConvergenceError is returned when the root-finding of RATE, IRR or XIRR doesn't converge
+// in Options.MaxIterations. It matches ErrNoConvergence with errors.Is.
+//
+// Example:
+//
+//	_, err := financial.IRR(flows, decimal.Require("0.1"), opts)
+//	var convergenceErr *financial.ConvergenceError
+//	if errors.As(err, &convergenceErr) {
+//		fmt.Println(convergenceErr.Last) // the last estimate
+//	}
+type ConvergenceError struct {
+	// Func is the name of the function, e.g. "IRR".
+	Func string
+	// Guess is the initial estimate.
+	Guess decimal.Decimal
+	// Iterations is the count of the iterations done.
+	Iterations int
+	// Last is the last estimate.
+	Last decimal.Decimal
+}
+
+// Error implements the error interface.
+func (e *ConvergenceError) Error() string {
+	return fmt.Sprintf("%s: %s from guess %s after %d iterations, last estimate %s",
+		e.Func, ErrNoConvergence, e.Guess, e.Iterations, e.Last)
+}
+
+// Unwrap returns ErrNoConvergence.
+func (e *ConvergenceError) Unwrap() error {
+	return ErrNoConvergence
+}
+
+// newton finds the rate where f(rate) = 0 from guess with the Newton iteration, fn returns f(rate) and f'(rate)
+// with the intermediate values rounded to places decimal places.
+//
+// The rate is rounded to the working precision in each iteration, otherwise its digits grow without bound,
+// and it stays greater than -1.
+func newton(name string, guess decimal.Decimal, opts Options, fn func(rate decimal.Decimal, places int) (f, df *big.Rat)) (decimal.Decimal, error) {
+	tolerance := opts.Tolerance
+	if tolerance.Sign() <= 0 {
+		tolerance = decimal.Require("1").Shift(-(opts.Precision + guardDigits/2))
+	}
+
+	iterations := opts.MaxIterations
+	if iterations <= 0 {
+		iterations = defaultMaxIterations
+	}
+
+	wp := max(opts.Precision, int(-tolerance.Exponent())) + guardDigits
+	tol := tolerance.Rat()
+	minusOne := one.Neg()
+
+	rate := guess
+	for i := range iterations {
+		f, df := fn(rate, 2*wp)
+		if df.Sign() == 0 {
+			return decimal.Zero, &ConvergenceError{Func: name, Guess: guess, Iterations: i, Last: rate}
+		}
+
+		step := f.Quo(f, df)
+		next := decimal.NewFromRat(new(big.Rat).Sub(rate.Rat(), step), wp, decimal.RoundingHalfEven)
+		if next.Cmp(minusOne) <= 0 {
+			return decimal.Zero, &ConvergenceError{Func: name, Guess: guess, Iterations: i + 1, Last: next}
+		}
+
+		rate = next
+		if step.Abs(step).Cmp(tol) <= 0 {
+			return round(rate.Rat(), opts), nil
+		}
+	}
+
+	return decimal.Zero, &ConvergenceError{Func: name, Guess: guess, Iterations: iterations, Last: rate}
+}
@@ -0,0 +1,72 @@
+package money
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//go:embed iso4217.csv
+var iso4217CSV string
+
+// currencies is the table of the ISO 4217 currencies keyed by the alphabetic code.
+var currencies = loadCurrencies(iso4217CSV)
+
+// Currency is an ISO 4217 currency.
+type Currency struct {
+	// Code is the alphabetic code, e.g. "USD".
+	Code string
+	// Numeric is the numeric code, e.g. "840".
+	Numeric string
+	// MinorUnits is the count of the digits after the decimal point of the minor unit, e.g. 2 for USD and 0 for JPY.
+	MinorUnits int
+	// Name is the English name, e.g. "US Dollar".
+	Name string
+}
+
+// LookupCurrency returns the ISO 4217 currency of the alphabetic code, the code is case-insensitive.
+//
+// Example:
+//
+//	c, ok := money.LookupCurrency("jpy")
+//	c.MinorUnits // 0
+func LookupCurrency(code string) (Currency, bool) {
+	c, ok := currencies[strings.ToUpper(code)]
+	return c, ok
+}
+
+// Currencies returns all the ISO 4217 currencies in the embedded table.
+func Currencies() []Currency {
+	result := make([]Currency, 0, len(currencies))
+	for _, c := range currencies {
+		result = append(result, c)
+	}
+
+	return result
+}
+
+func loadCurrencies(data string) map[string]Currency {
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		panic(fmt.Sprintf("load iso4217 table, err: %+v", err))
+	}
+
+	table := make(map[string]Currency, len(records))
+	for _, record := range records[1:] {
+		minorUnits, err := strconv.Atoi(record[2])
+		if err != nil {
+			panic(fmt.Sprintf("load iso4217 table, invalid minor units of %s, err: %+v", record[0], err))
+		}
+
+		table[record[0]] = Currency{
+			Code:       record[0],
+			Numeric:    record[1],
+			MinorUnits: minorUnits,
+			Name:       record[3],
+		}
+	}
+
+	return table
+}
@@ -0,0 +1,333 @@
+// Package money pairs decimal.Decimal with an ISO 4217 currency.
+//
+// The arithmetic between two Money values returns ErrCurrencyMismatch when their currencies are different,
+// and the minor unit of each currency, e.g. 2 digits for USD and 0 for JPY, comes from the embedded ISO 4217 table.
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/yanun0323/decimal"
+)
+
+var (
+	// ErrCurrencyMismatch is returned when the operands of the arithmetic have different currencies.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	// ErrUnknownCurrency is returned when the currency code is not in the ISO 4217 table.
+	ErrUnknownCurrency = errors.New("unknown currency")
+)
+
+// Money is an amount of a currency.
+//
+// The zero value has no currency, it's 0 and only equals to itself.
+type Money struct {
+	amount   decimal.Decimal
+	currency Currency
+}
+
+// New returns the Money of the amount and the ISO 4217 currency code.
+//
+// Example:
+//
+//	m, err := money.New(decimal.Require("12.5"), "USD")
+//	m.String() // "12.50 USD"
+func New(amount decimal.Decimal, code string) (Money, error) {
+	c, ok := LookupCurrency(code)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %s", ErrUnknownCurrency, code)
+	}
+
+	if !amount.IsValid() {
+		return Money{}, fmt.Errorf("invalid amount: %s", string(amount))
+	}
+
+	return Money{amount: amount.Canonical(), currency: c}, nil
+}
+
+// Require returns the Money of the amount and the ISO 4217 currency code, or panics if New would have returned an error.
+func Require(amount decimal.Decimal, code string) Money {
+	m, err := New(amount, code)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// Zero returns zero of the currency.
+func Zero(code string) (Money, error) {
+	return New(decimal.Zero, code)
+}
+
+// NewFromMinor returns the Money from the amount in the minor unit of the currency.
+//
+// Example:
+//
+//	m, err := money.NewFromMinor(1250, "USD")
+//	m.String() // "12.50 USD"
+func NewFromMinor(minor int64, code string) (Money, error) {
+	c, ok := LookupCurrency(code)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %s", ErrUnknownCurrency, code)
+	}
+
+	return Money{amount: decimal.NewFromInt(minor).Shift(-c.MinorUnits).Canonical(), currency: c}, nil
+}
+
+// Parse parses the string in the format of String, e.g. "12.50 USD".
+func Parse(s string) (Money, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return Money{}, fmt.Errorf("invalid money format: %s", s)
+	}
+
+	amount, err := decimal.New(fields[0])
+	if err != nil {
+		return Money{}, err
+	}
+
+	return New(amount, fields[1])
+}
+
+// Amount returns the amount.
+func (m Money) Amount() decimal.Decimal {
+	if len(m.amount) == 0 {
+		return decimal.Zero
+	}
+
+	return m.amount
+}
+
+// Currency returns the currency.
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+// Minor returns the amount in the minor unit of the currency,
+// ok is false when the amount has more digits than the minor unit or it overflows int64.
+//
+// Example:
+//
+//	money.Require(decimal.Require("12.5"), "USD").Minor()   // 1250, true
+//	money.Require(decimal.Require("12.505"), "USD").Minor() // 1250, false
+func (m Money) Minor() (minor int64, ok bool) {
+	return m.Amount().Shift(m.currency.MinorUnits).Int64()
+}
+
+// Add returns m + m2, or ErrCurrencyMismatch when the currencies are different.
+func (m Money) Add(m2 Money) (Money, error) {
+	if err := m.check(m2); err != nil {
+		return Money{}, err
+	}
+
+	return m.with(m.Amount().Add(m2.Amount())), nil
+}
+
+// Sub returns m - m2, or ErrCurrencyMismatch when the currencies are different.
+func (m Money) Sub(m2 Money) (Money, error) {
+	if err := m.check(m2); err != nil {
+		return Money{}, err
+	}
+
+	return m.with(m.Amount().Sub(m2.Amount())), nil
+}
+
+// Mul returns m * factor, the result is not rounded to the minor unit.
+func (m Money) Mul(factor decimal.Decimal) Money {
+	return m.with(m.Amount().Mul(factor))
+}
+
+// Div returns m / divisor with DivisionPrecision, the result is not rounded to the minor unit.
+func (m Money) Div(divisor decimal.Decimal) Money {
+	return m.with(m.Amount().Div(divisor))
+}
+
+// Neg returns -m.
+func (m Money) Neg() Money {
+	return m.with(m.Amount().Neg())
+}
+
+// Abs returns the absolute value of m.
+func (m Money) Abs() Money {
+	return m.with(m.Amount().Abs())
+}
+
+// Round rounds the amount to the minor unit of the currency with the rounding mode.
+//
+// Example:
+//
+//	money.Require(decimal.Require("2.345"), "USD").Round(decimal.RoundingHalfEven) // "2.34 USD"
+//	money.Require(decimal.Require("2.5"), "JPY").Round(decimal.RoundingHalfUp)     // "3 JPY"
+func (m Money) Round(mode decimal.RoundingMode) Money {
+	return m.with(m.Amount().RoundWithMode(m.currency.MinorUnits, mode))
+}
+
+// Allocate splits m into parts proportional to the ratios in the minor unit of the currency,
+// and the parts sum exactly to m. See decimal.Decimal.Allocate for the distribution of the remainder.
+//
+// It panics when the amount has more digits than the minor unit, round it first.
+//
+// Example:
+//
+//	money.Require(decimal.Require("100"), "USD").Allocate([]decimal.Decimal{"1", "1", "1"}) // 33.34, 33.33, 33.33 USD
+func (m Money) Allocate(ratios []decimal.Decimal) []Money {
+	return m.withParts(m.Amount().Allocate(ratios, m.currency.MinorUnits))
+}
+
+// Split splits m into n parts which are as equal as possible in the minor unit of the currency,
+// and the parts sum exactly to m.
+//
+// It panics when n is not positive or the amount has more digits than the minor unit, round it first.
+func (m Money) Split(n int) []Money {
+	return m.withParts(m.Amount().Split(n, m.currency.MinorUnits))
+}
+
+// IsZero reports whether the amount is 0.
+func (m Money) IsZero() bool {
+	return m.Amount().IsZero()
+}
+
+// Sign returns the sign of the amount, 1 if m > 0, 0 if m == 0, -1 if m < 0.
+func (m Money) Sign() int {
+	return m.Amount().Sign()
+}
+
+// Cmp compares m and m2, or returns ErrCurrencyMismatch when the currencies are different.
+func (m Money) Cmp(m2 Money) (int, error) {
+	if err := m.check(m2); err != nil {
+		return 0, err
+	}
+
+	return m.Amount().Cmp(m2.Amount()), nil
+}
+
+// Equal reports whether m and m2 have the same currency and the same amount.
+func (m Money) Equal(m2 Money) bool {
+	return m.currency.Code == m2.currency.Code && m.Amount().Equal(m2.Amount())
+}
+
+// String returns the amount with at least the digits of the minor unit and the currency code, e.g. "12.50 USD".
+// The amount is not rounded, e.g. "12.505 USD".
+func (m Money) String() string {
+	if len(m.currency.Code) == 0 {
+		return m.Amount().String()
+	}
+
+	return m.amountString() + " " + m.currency.Code
+}
+
+type moneyJSON struct {
+	Amount   json.RawMessage `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+// MarshalJSON implements the json.Marshaler interface, e.g. {"amount":"12.50","currency":"USD"}.
+// The zero value, which has no currency, is marshaled as null.
+func (m Money) MarshalJSON() ([]byte, error) {
+	if len(m.currency.Code) == 0 {
+		return []byte("null"), nil
+	}
+
+	amount, err := json.Marshal(m.amountString())
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(moneyJSON{Amount: amount, Currency: m.currency.Code})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, the amount can be either a string or a number.
+// null is a no-op like the other types of encoding/json.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var v moneyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	amount := string(v.Amount)
+	if len(v.Amount) != 0 && v.Amount[0] == '"' {
+		if err := json.Unmarshal(v.Amount, &amount); err != nil {
+			return err
+		}
+	}
+
+	d, err := decimal.New(amount)
+	if err != nil {
+		return err
+	}
+
+	result, err := New(d, v.Currency)
+	if err != nil {
+		return err
+	}
+
+	*m = result
+	return nil
+}
+
+// Scan implements the sql.Scanner interface for database deserialization, in the format of String.
+func (m *Money) Scan(value any) error {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("could not convert value '%+v' to money of type '%T'", value, value)
+	}
+
+	result, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*m = result
+	return nil
+}
+
+// Value implements the driver.Valuer interface for database writes, in the format of String.
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+func (m Money) check(m2 Money) error {
+	if m.currency.Code != m2.currency.Code {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency.Code, m2.currency.Code)
+	}
+
+	return nil
+}
+
+// with returns the Money of the amount in the currency of m, the amount is kept canonical
+// so that its exponent is the digits of String.
+func (m Money) with(amount decimal.Decimal) Money {
+	return Money{amount: amount.Canonical(), currency: m.currency}
+}
+
+func (m Money) withParts(amounts []decimal.Decimal) []Money {
+	parts := make([]Money, len(amounts))
+	for i, amount := range amounts {
+		parts[i] = m.with(amount)
+	}
+
+	return parts
+}
+
+// amountString returns the amount padded to the digits of the minor unit.
+func (m Money) amountString() string {
+	amount := m.Amount()
+	if int(-amount.Exponent()) >= m.currency.MinorUnits {
+		return amount.String()
+	}
+
+	return amount.StringFixed(m.currency.MinorUnits)
+}
@@ -0,0 +1,197 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/yanun0323/decimal"
+)
+
+func TestMoney(t *testing.T) {
+	suite.Run(t, new(MoneySuite))
+}
+
+type MoneySuite struct {
+	suite.Suite
+}
+
+func (su *MoneySuite) TestLookupCurrency() {
+	testCases := []struct {
+		code       string
+		minorUnits int
+		numeric    string
+	}{
+		{"USD", 2, "840"},
+		{"jpy", 0, "392"},
+		{"KWD", 3, "414"},
+		{"CLF", 4, "990"},
+		{"ALL", 2, "008"},
+	}
+
+	for _, tc := range testCases {
+		c, ok := LookupCurrency(tc.code)
+		su.Require().True(ok, tc.code)
+		su.Equal(tc.minorUnits, c.MinorUnits, tc.code)
+		su.Equal(tc.numeric, c.Numeric, tc.code)
+	}
+
+	_, ok := LookupCurrency("XXX1")
+	su.False(ok)
+	su.Greater(len(Currencies()), 150)
+}
+
+func (su *MoneySuite) TestNew() {
+	m, err := New(decimal.Require("12.5"), "usd")
+	su.Require().NoError(err)
+	su.Equal("12.50 USD", m.String())
+	su.Equal("USD", m.Currency().Code)
+	su.Equal("12.5", m.Amount().String())
+
+	_, err = New(decimal.Require("1"), "ABC")
+	su.True(errors.Is(err, ErrUnknownCurrency))
+
+	_, err = New(decimal.Decimal("1.2.3"), "USD")
+	su.Error(err)
+
+	m, err = NewFromMinor(-1250, "USD")
+	su.Require().NoError(err)
+	su.Equal("-12.50 USD", m.String())
+
+	m, err = NewFromMinor(1250, "JPY")
+	su.Require().NoError(err)
+	su.Equal("1250 JPY", m.String())
+
+	m, err = Zero("KWD")
+	su.Require().NoError(err)
+	su.Equal("0.000 KWD", m.String())
+
+	su.Panics(func() { Require(decimal.Require("1"), "ABC") })
+	su.Equal("0", Money{}.String())
+}
+
+func (su *MoneySuite) TestArithmetic() {
+	a := Require(decimal.Require("10.25"), "USD")
+	b := Require(decimal.Require("0.75"), "USD")
+	yen := Require(decimal.Require("100"), "JPY")
+
+	sum, err := a.Add(b)
+	su.Require().NoError(err)
+	su.Equal("11.00 USD", sum.String())
+
+	diff, err := b.Sub(a)
+	su.Require().NoError(err)
+	su.Equal("-9.50 USD", diff.String())
+
+	_, err = a.Add(yen)
+	su.True(errors.Is(err, ErrCurrencyMismatch))
+
+	_, err = a.Sub(yen)
+	su.True(errors.Is(err, ErrCurrencyMismatch))
+
+	_, err = a.Cmp(yen)
+	su.True(errors.Is(err, ErrCurrencyMismatch))
+
+	c, err := a.Cmp(b)
+	su.Require().NoError(err)
+	su.Equal(1, c)
+
+	su.Equal("10.3525 USD", a.Mul(decimal.Require("1.01")).String())
+	su.Equal("3.4166666666666666 USD", a.Div(decimal.Require("3")).String())
+	su.Equal("-10.25 USD", a.Neg().String())
+	su.Equal("10.25 USD", a.Neg().Abs().String())
+	su.Equal(-1, a.Neg().Sign())
+	su.True(Require(decimal.Zero, "USD").IsZero())
+
+	su.True(a.Equal(Require(decimal.Decimal("10.250"), "USD")))
+	su.False(yen.Equal(Require(decimal.Require("100"), "USD")))
+}
+
+func (su *MoneySuite) TestRound() {
+	testCases := []struct {
+		amount   string
+		code     string
+		mode     decimal.RoundingMode
+		expected string
+	}{
+		{"2.345", "USD", decimal.RoundingHalfEven, "2.34 USD"},
+		{"2.345", "USD", decimal.RoundingHalfUp, "2.35 USD"},
+		{"2.5", "JPY", decimal.RoundingHalfUp, "3 JPY"},
+		{"-2.5", "JPY", decimal.RoundingFloor, "-3 JPY"},
+		{"1.23456", "KWD", decimal.RoundingTowardToZero, "1.234 KWD"},
+	}
+
+	for _, tc := range testCases {
+		m := Require(decimal.Require(tc.amount), tc.code).Round(tc.mode)
+		su.Equal(tc.expected, m.String(), tc.amount)
+
+		minor, ok := m.Minor()
+		su.True(ok, tc.amount)
+		su.Equal(m.Amount().Shift(m.Currency().MinorUnits).IntPart(), minor, tc.amount)
+	}
+
+	_, ok := Require(decimal.Require("12.505"), "USD").Minor()
+	su.False(ok)
+}
+
+func (su *MoneySuite) TestSerialization() {
+	m := Require(decimal.Require("12.5"), "USD")
+
+	data, err := json.Marshal(m)
+	su.Require().NoError(err)
+	su.Equal(`{"amount":"12.50","currency":"USD"}`, string(data))
+
+	var decoded Money
+	su.Require().NoError(json.Unmarshal(data, &decoded))
+	su.True(m.Equal(decoded))
+
+	su.Require().NoError(json.Unmarshal([]byte(`{"amount":3.5,"currency":"EUR"}`), &decoded))
+	su.Equal("3.50 EUR", decoded.String())
+
+	su.Error(json.Unmarshal([]byte(`{"amount":"1","currency":"ABC"}`), &decoded))
+	su.Error(json.Unmarshal([]byte(`{"amount":"abc","currency":"USD"}`), &decoded))
+
+	// the zero value round-trips through null
+	data, err = json.Marshal(Money{})
+	su.Require().NoError(err)
+	su.Equal("null", string(data))
+
+	decoded = Money{}
+	su.Require().NoError(json.Unmarshal(data, &decoded))
+	su.True(decoded.Equal(Money{}))
+
+	var pointer struct{ M *Money }
+	su.Require().NoError(json.Unmarshal([]byte(`{"M":null}`), &pointer))
+	su.Nil(pointer.M)
+
+	value, err := m.Value()
+	su.Require().NoError(err)
+	su.Equal("12.50 USD", value)
+
+	var scanned Money
+	su.Require().NoError(scanned.Scan([]byte("12.50 USD")))
+	su.True(m.Equal(scanned))
+	su.Require().NoError(scanned.Scan("-1 JPY"))
+	su.Equal("-1 JPY", scanned.String())
+	su.Error(scanned.Scan(12.5))
+	su.Error(scanned.Scan("12.50"))
+
+	parsed, err := Parse("1,000.5 GBP")
+	su.Require().NoError(err)
+	su.Equal("1000.50 GBP", parsed.String())
+}
+
+func (su *MoneySuite) TestAllocate() {
+	parts := Require(decimal.Require("100"), "USD").Split(3)
+	su.Len(parts, 3)
+	su.Equal("33.34 USD", parts[0].String())
+	su.Equal("33.33 USD", parts[1].String())
+	su.Equal("33.33 USD", parts[2].String())
+
+	parts = Require(decimal.Require("1000"), "JPY").Allocate([]decimal.Decimal{"1", "2"})
+	su.Equal("333 JPY", parts[0].String())
+	su.Equal("667 JPY", parts[1].String())
+
+	su.Panics(func() { Require(decimal.Require("1.005"), "USD").Split(2) })
+}